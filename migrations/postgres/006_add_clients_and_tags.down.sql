@@ -0,0 +1,14 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_time_entry_tags_tag_id;
+DROP INDEX IF EXISTS idx_projects_client_id;
+DROP INDEX IF EXISTS idx_clients_user_id;
+
+-- Drop tag tables
+DROP TABLE IF EXISTS time_entry_tags;
+DROP TABLE IF EXISTS tags;
+
+-- Remove client_id column from projects table
+ALTER TABLE projects DROP COLUMN IF EXISTS client_id;
+
+-- Drop clients table
+DROP TABLE IF EXISTS clients;
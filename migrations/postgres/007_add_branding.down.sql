@@ -0,0 +1,3 @@
+-- Drop branding table
+DROP INDEX IF EXISTS idx_branding_default;
+DROP TABLE IF EXISTS branding;
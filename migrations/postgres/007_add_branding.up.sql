@@ -0,0 +1,20 @@
+-- Create branding table; the row without client_id holds the default branding,
+-- rows with a client_id override it for that client's reports
+CREATE TABLE IF NOT EXISTS branding (
+    id SERIAL PRIMARY KEY,
+    client_id INTEGER UNIQUE REFERENCES clients(id) ON DELETE CASCADE,
+    company_name VARCHAR(255) NOT NULL DEFAULT '',
+    company_address TEXT NOT NULL DEFAULT '',
+    primary_color VARCHAR(7) NOT NULL DEFAULT '',
+    footer_text VARCHAR(255) NOT NULL DEFAULT '',
+    logo BYTEA,
+    logo_content_type VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Only one default branding row
+CREATE UNIQUE INDEX IF NOT EXISTS idx_branding_default ON branding ((client_id IS NULL)) WHERE client_id IS NULL;
+
+-- Insert default branding row
+INSERT INTO branding (primary_color, footer_text) VALUES ('#3498db', 'Side Sync Time Tracking');
@@ -0,0 +1,2 @@
+-- Remove locale column from clients table
+ALTER TABLE clients DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,2 @@
+-- Add default report locale to clients table
+ALTER TABLE clients ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT '';
@@ -0,0 +1,15 @@
+-- Remove rounding policies
+DROP VIEW IF EXISTS rounded_time_entries;
+DROP FUNCTION IF EXISTS round_duration(BIGINT, VARCHAR, INTEGER);
+
+ALTER TABLE projects DROP COLUMN IF EXISTS rounding_scope;
+ALTER TABLE projects DROP COLUMN IF EXISTS rounding_minutes;
+ALTER TABLE projects DROP COLUMN IF EXISTS rounding_mode;
+
+ALTER TABLE clients DROP COLUMN IF EXISTS rounding_scope;
+ALTER TABLE clients DROP COLUMN IF EXISTS rounding_minutes;
+ALTER TABLE clients DROP COLUMN IF EXISTS rounding_mode;
+
+ALTER TABLE settings DROP COLUMN IF EXISTS rounding_scope;
+ALTER TABLE settings DROP COLUMN IF EXISTS rounding_minutes;
+ALTER TABLE settings DROP COLUMN IF EXISTS rounding_mode;
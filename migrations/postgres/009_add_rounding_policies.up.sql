@@ -0,0 +1,61 @@
+-- Add rounding policies; settings hold the default, clients and projects
+-- override it when their rounding_mode is set
+ALTER TABLE settings ADD COLUMN rounding_mode VARCHAR(10) NOT NULL DEFAULT 'none';
+ALTER TABLE settings ADD COLUMN rounding_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE settings ADD COLUMN rounding_scope VARCHAR(10) NOT NULL DEFAULT 'entry';
+
+ALTER TABLE clients ADD COLUMN rounding_mode VARCHAR(10);
+ALTER TABLE clients ADD COLUMN rounding_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clients ADD COLUMN rounding_scope VARCHAR(10) NOT NULL DEFAULT 'entry';
+
+ALTER TABLE projects ADD COLUMN rounding_mode VARCHAR(10);
+ALTER TABLE projects ADD COLUMN rounding_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN rounding_scope VARCHAR(10) NOT NULL DEFAULT 'entry';
+
+-- Round a duration in seconds up, to the nearest or down to a multiple of minutes
+CREATE OR REPLACE FUNCTION round_duration(seconds BIGINT, mode VARCHAR, minutes INTEGER) RETURNS INTEGER AS $$
+    SELECT CASE
+        WHEN seconds IS NULL OR seconds <= 0 OR minutes <= 0 THEN seconds::INTEGER
+        WHEN mode = 'up' THEN (CEIL(seconds / (minutes * 60.0)) * minutes * 60)::INTEGER
+        WHEN mode = 'nearest' THEN (ROUND(seconds / (minutes * 60.0)) * minutes * 60)::INTEGER
+        WHEN mode = 'down' THEN (FLOOR(seconds / (minutes * 60.0)) * minutes * 60)::INTEGER
+        ELSE seconds::INTEGER
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Rounded duration of every time entry under the policy of its project. With
+-- the day scope, a project's billable and non-billable entries are rounded as
+-- daily totals that are spread over the day's entries in proportion to their
+-- durations. Raw durations stay untouched in time_entries.
+CREATE OR REPLACE VIEW rounded_time_entries AS
+WITH policies AS (
+    SELECT p.id AS project_id,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_mode
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_mode
+             ELSE s.rounding_mode END AS mode,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_minutes
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_minutes
+             ELSE s.rounding_minutes END AS minutes,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_scope
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_scope
+             ELSE s.rounding_scope END AS scope
+    FROM projects p
+    LEFT JOIN clients c ON c.id = p.client_id
+    LEFT JOIN (SELECT rounding_mode, rounding_minutes, rounding_scope FROM settings ORDER BY id LIMIT 1) s ON true
+),
+days AS (
+    SELECT te.id, te.duration, pol.mode, pol.minutes, pol.scope,
+        SUM(te.duration) OVER day AS day_total,
+        SUM(te.duration) OVER (day ORDER BY te.start_time, te.id) AS day_running
+    FROM time_entries te
+    JOIN policies pol ON pol.project_id = te.project_id
+    WINDOW day AS (PARTITION BY te.project_id, DATE(te.start_time), te.billable)
+)
+SELECT id,
+    CASE
+        WHEN scope = 'day' AND day_total > 0 THEN
+            (ROUND(day_running * round_duration(day_total, mode, minutes)::NUMERIC / day_total)
+             - ROUND((day_running - duration) * round_duration(day_total, mode, minutes)::NUMERIC / day_total))::INTEGER
+        ELSE round_duration(duration, mode, minutes)
+    END AS duration
+FROM days;
@@ -0,0 +1,3 @@
+-- Drop timesheet period tables
+DROP TABLE IF EXISTS timesheet_period_events;
+DROP TABLE IF EXISTS timesheet_periods;
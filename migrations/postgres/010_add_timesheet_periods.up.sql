@@ -0,0 +1,28 @@
+-- Create timesheet_periods table; one row per user and week once the week
+-- has been submitted, weeks without a row are open
+CREATE TABLE IF NOT EXISTS timesheet_periods (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    comment TEXT NOT NULL DEFAULT '',
+    submitted_at TIMESTAMP WITH TIME ZONE,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (user_id, week_start)
+);
+
+-- Create timesheet_period_events table holding every status change with its comment
+CREATE TABLE IF NOT EXISTS timesheet_period_events (
+    id SERIAL PRIMARY KEY,
+    period_id INTEGER NOT NULL REFERENCES timesheet_periods(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_timesheet_periods_status ON timesheet_periods(status);
+CREATE INDEX IF NOT EXISTS idx_timesheet_period_events_period_id ON timesheet_period_events(period_id);
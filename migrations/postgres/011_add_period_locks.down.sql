@@ -0,0 +1,4 @@
+-- Remove period locks
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+ALTER TABLE projects DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE settings DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,8 @@
+-- Lock time entries dated on or before locked_until; a project's own date
+-- replaces the one from settings
+ALTER TABLE settings ADD COLUMN locked_until DATE;
+ALTER TABLE projects ADD COLUMN locked_until DATE;
+
+-- Admins may override the period lock
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT false;
+UPDATE users SET is_admin = true WHERE id = (SELECT MIN(id) FROM users);
@@ -0,0 +1,12 @@
+-- Restore the admin flag from workspace roles
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT false;
+UPDATE users SET is_admin = true WHERE id IN (SELECT user_id FROM workspace_members WHERE role IN ('owner', 'admin'));
+
+-- Drop workspaces
+DROP INDEX IF EXISTS idx_clients_workspace_id;
+DROP INDEX IF EXISTS idx_projects_workspace_id;
+ALTER TABLE clients DROP COLUMN IF EXISTS workspace_id;
+ALTER TABLE projects DROP COLUMN IF EXISTS workspace_id;
+DROP TABLE IF EXISTS project_members;
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,51 @@
+-- Create workspaces table
+CREATE TABLE IF NOT EXISTS workspaces (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create workspace_members table; role is one of owner, admin, member or viewer
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'member',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+-- Create project_members table; members only log time on projects they are assigned to
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+ALTER TABLE projects ADD COLUMN workspace_id INTEGER REFERENCES workspaces(id) ON DELETE CASCADE;
+ALTER TABLE clients ADD COLUMN workspace_id INTEGER REFERENCES workspaces(id) ON DELETE CASCADE;
+
+-- Move existing data into a default workspace; admins become its owners
+INSERT INTO workspaces (name) VALUES ('Default');
+
+INSERT INTO workspace_members (workspace_id, user_id, role)
+SELECT w.id, u.id, CASE WHEN u.is_admin THEN 'owner' ELSE 'member' END
+FROM users u CROSS JOIN (SELECT MIN(id) AS id FROM workspaces) w;
+
+UPDATE projects SET workspace_id = (SELECT MIN(id) FROM workspaces);
+UPDATE clients SET workspace_id = (SELECT MIN(id) FROM workspaces);
+
+ALTER TABLE projects ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE clients ALTER COLUMN workspace_id SET NOT NULL;
+
+INSERT INTO project_members (project_id, user_id)
+SELECT p.id, m.user_id FROM projects p JOIN workspace_members m ON m.workspace_id = p.workspace_id;
+
+-- Roles replace the admin flag
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+
+CREATE INDEX IF NOT EXISTS idx_workspace_members_user_id ON workspace_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_project_members_user_id ON project_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_projects_workspace_id ON projects(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_clients_workspace_id ON clients(workspace_id);
@@ -0,0 +1,73 @@
+-- Settings belong to a workspace; users override parts of them in user_settings
+ALTER TABLE settings ADD COLUMN workspace_id INTEGER REFERENCES workspaces(id) ON DELETE CASCADE;
+ALTER TABLE settings ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE settings ADD COLUMN week_start_day INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE settings ADD COLUMN working_hours_per_day DECIMAL(4,2) NOT NULL DEFAULT 8;
+ALTER TABLE settings ADD COLUMN date_format VARCHAR(20) NOT NULL DEFAULT 'YYYY-MM-DD';
+ALTER TABLE settings ADD COLUMN default_billable BOOLEAN NOT NULL DEFAULT true;
+
+-- The existing row becomes the settings of the default workspace; other
+-- workspaces start from a copy of it
+UPDATE settings SET workspace_id = (SELECT MIN(id) FROM workspaces) WHERE id = (SELECT MIN(id) FROM settings);
+DELETE FROM settings WHERE workspace_id IS NULL;
+
+INSERT INTO settings (workspace_id, default_hourly_rate, currency, rounding_mode, rounding_minutes, rounding_scope, locked_until)
+SELECT w.id, s.default_hourly_rate, COALESCE(s.currency, 'EUR'), COALESCE(s.rounding_mode, 'none'), COALESCE(s.rounding_minutes, 0), COALESCE(s.rounding_scope, 'entry'), s.locked_until
+FROM workspaces w
+LEFT JOIN (SELECT * FROM settings ORDER BY id LIMIT 1) s ON true
+WHERE w.id NOT IN (SELECT workspace_id FROM settings);
+
+ALTER TABLE settings ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE settings ADD CONSTRAINT settings_workspace_id_key UNIQUE (workspace_id);
+
+-- Create user_settings table; NULL columns inherit the workspace settings
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    default_hourly_rate DECIMAL(10,2),
+    currency VARCHAR(10),
+    timezone VARCHAR(64),
+    week_start_day INTEGER,
+    working_hours_per_day DECIMAL(4,2),
+    date_format VARCHAR(20),
+    default_billable BOOLEAN,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Rounded duration of every time entry under the policy of its project, whose
+-- default now comes from the settings of the project's workspace. With
+-- the day scope, a project's billable and non-billable entries are rounded as
+-- daily totals that are spread over the day's entries in proportion to their
+-- durations. Raw durations stay untouched in time_entries.
+CREATE OR REPLACE VIEW rounded_time_entries AS
+WITH policies AS (
+    SELECT p.id AS project_id,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_mode
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_mode
+             ELSE s.rounding_mode END AS mode,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_minutes
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_minutes
+             ELSE s.rounding_minutes END AS minutes,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_scope
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_scope
+             ELSE s.rounding_scope END AS scope
+    FROM projects p
+    LEFT JOIN clients c ON c.id = p.client_id
+    LEFT JOIN settings s ON s.workspace_id = p.workspace_id
+),
+days AS (
+    SELECT te.id, te.duration, pol.mode, pol.minutes, pol.scope,
+        SUM(te.duration) OVER day AS day_total,
+        SUM(te.duration) OVER (day ORDER BY te.start_time, te.id) AS day_running
+    FROM time_entries te
+    JOIN policies pol ON pol.project_id = te.project_id
+    WINDOW day AS (PARTITION BY te.project_id, DATE(te.start_time), te.billable)
+)
+SELECT id,
+    CASE
+        WHEN scope = 'day' AND day_total > 0 THEN
+            (ROUND(day_running * round_duration(day_total, mode, minutes)::NUMERIC / day_total)
+             - ROUND((day_running - duration) * round_duration(day_total, mode, minutes)::NUMERIC / day_total))::INTEGER
+        ELSE round_duration(duration, mode, minutes)
+    END AS duration
+FROM days;
@@ -0,0 +1,38 @@
+-- Rounded duration of every time entry under the policy of its project. With
+-- the day scope, a project's billable and non-billable entries are rounded as
+-- daily totals that are spread over the day's entries in proportion to their
+-- durations; days are calendar days in the timezone of the project's
+-- workspace. Raw durations stay untouched in time_entries.
+CREATE OR REPLACE VIEW rounded_time_entries AS
+WITH policies AS (
+    SELECT p.id AS project_id,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_mode
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_mode
+             ELSE s.rounding_mode END AS mode,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_minutes
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_minutes
+             ELSE s.rounding_minutes END AS minutes,
+        CASE WHEN p.rounding_mode IS NOT NULL THEN p.rounding_scope
+             WHEN c.rounding_mode IS NOT NULL THEN c.rounding_scope
+             ELSE s.rounding_scope END AS scope,
+        COALESCE(s.timezone, 'UTC') AS timezone
+    FROM projects p
+    LEFT JOIN clients c ON c.id = p.client_id
+    LEFT JOIN settings s ON s.workspace_id = p.workspace_id
+),
+days AS (
+    SELECT te.id, te.duration, pol.mode, pol.minutes, pol.scope,
+        SUM(te.duration) OVER day AS day_total,
+        SUM(te.duration) OVER (day ORDER BY te.start_time, te.id) AS day_running
+    FROM time_entries te
+    JOIN policies pol ON pol.project_id = te.project_id
+    WINDOW day AS (PARTITION BY te.project_id, DATE(te.start_time AT TIME ZONE pol.timezone), te.billable)
+)
+SELECT id,
+    CASE
+        WHEN scope = 'day' AND day_total > 0 THEN
+            (ROUND(day_running * round_duration(day_total, mode, minutes)::NUMERIC / day_total)
+             - ROUND((day_running - duration) * round_duration(day_total, mode, minutes)::NUMERIC / day_total))::INTEGER
+        ELSE round_duration(duration, mode, minutes)
+    END AS duration
+FROM days;
@@ -0,0 +1,2 @@
+-- Drop time entry history
+DROP TABLE IF EXISTS time_entry_history;
@@ -0,0 +1,13 @@
+-- Create time_entry_history table holding every split and merge with the
+-- affected entries as they were before and after it
+CREATE TABLE IF NOT EXISTS time_entry_history (
+    id SERIAL PRIMARY KEY,
+    action VARCHAR(20) NOT NULL,
+    entries_before JSONB NOT NULL,
+    entries_after JSONB NOT NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_entry_history_before ON time_entry_history USING GIN (entries_before jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_time_entry_history_after ON time_entry_history USING GIN (entries_after jsonb_path_ops);
@@ -0,0 +1,7 @@
+-- Keep the default branding of the oldest workspace as the global default
+DELETE FROM branding WHERE client_id IS NULL AND workspace_id <> (SELECT MIN(workspace_id) FROM branding WHERE client_id IS NULL);
+
+DROP INDEX IF EXISTS idx_branding_default;
+ALTER TABLE branding DROP COLUMN IF EXISTS workspace_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_branding_default ON branding ((client_id IS NULL)) WHERE client_id IS NULL;
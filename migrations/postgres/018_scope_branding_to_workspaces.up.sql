@@ -0,0 +1,19 @@
+-- Each workspace gets its own default branding; client rows belong to the
+-- client's workspace
+ALTER TABLE branding ADD COLUMN workspace_id INTEGER REFERENCES workspaces(id) ON DELETE CASCADE;
+
+UPDATE branding b SET workspace_id = c.workspace_id FROM clients c WHERE c.id = b.client_id;
+
+-- Copy the former global default to every workspace
+INSERT INTO branding (workspace_id, company_name, company_address, primary_color, footer_text, logo, logo_content_type)
+SELECT w.id, b.company_name, b.company_address, b.primary_color, b.footer_text, b.logo, b.logo_content_type
+FROM workspaces w CROSS JOIN branding b
+WHERE b.client_id IS NULL AND b.workspace_id IS NULL;
+
+DELETE FROM branding WHERE workspace_id IS NULL;
+
+ALTER TABLE branding ALTER COLUMN workspace_id SET NOT NULL;
+
+-- Only one default branding row per workspace
+DROP INDEX IF EXISTS idx_branding_default;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_branding_default ON branding (workspace_id) WHERE client_id IS NULL;
@@ -0,0 +1,11 @@
+-- Merge tags with the same name back into one global tag
+ALTER TABLE tags DROP CONSTRAINT IF EXISTS tags_workspace_id_name_key;
+
+UPDATE time_entry_tags tet SET tag_id = keep.id
+FROM tags t, (SELECT MIN(id) AS id, name FROM tags GROUP BY name) keep
+WHERE t.id = tet.tag_id AND keep.name = t.name AND tet.tag_id <> keep.id;
+
+DELETE FROM tags t WHERE t.id <> (SELECT MIN(id) FROM tags WHERE name = t.name);
+
+ALTER TABLE tags DROP COLUMN IF EXISTS workspace_id;
+ALTER TABLE tags ADD CONSTRAINT tags_name_key UNIQUE (name);
@@ -0,0 +1,28 @@
+-- Tags belong to a workspace; a name shared by several workspaces becomes
+-- one tag in each
+ALTER TABLE tags ADD COLUMN workspace_id INTEGER REFERENCES workspaces(id) ON DELETE CASCADE;
+ALTER TABLE tags DROP CONSTRAINT IF EXISTS tags_name_key;
+
+INSERT INTO tags (workspace_id, name, created_at)
+SELECT DISTINCT p.workspace_id, t.name, t.created_at
+FROM tags t
+JOIN time_entry_tags tet ON tet.tag_id = t.id
+JOIN time_entries te ON te.id = tet.time_entry_id
+JOIN projects p ON p.id = te.project_id
+WHERE t.workspace_id IS NULL;
+
+-- Unused tags go to the oldest workspace
+INSERT INTO tags (workspace_id, name, created_at)
+SELECT (SELECT MIN(id) FROM workspaces), t.name, t.created_at
+FROM tags t
+WHERE t.workspace_id IS NULL AND NOT EXISTS (SELECT 1 FROM time_entry_tags tet WHERE tet.tag_id = t.id);
+
+UPDATE time_entry_tags tet SET tag_id = nt.id
+FROM time_entries te, projects p, tags ot, tags nt
+WHERE te.id = tet.time_entry_id AND p.id = te.project_id AND ot.id = tet.tag_id
+    AND nt.workspace_id = p.workspace_id AND nt.name = ot.name;
+
+DELETE FROM tags WHERE workspace_id IS NULL;
+
+ALTER TABLE tags ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE tags ADD CONSTRAINT tags_workspace_id_name_key UNIQUE (workspace_id, name);
@@ -0,0 +1,3 @@
+-- Restore the rate and currency overrides of user_settings
+ALTER TABLE user_settings ADD COLUMN default_hourly_rate DECIMAL(10,2);
+ALTER TABLE user_settings ADD COLUMN currency VARCHAR(10);
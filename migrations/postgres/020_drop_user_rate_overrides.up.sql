@@ -0,0 +1,4 @@
+-- Rates and currency come from the workspace, client and project settings
+-- only; users override display and time keeping preferences
+ALTER TABLE user_settings DROP COLUMN IF EXISTS default_hourly_rate;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,2 @@
+-- Drop the stored response headers
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS response_headers;
@@ -0,0 +1,3 @@
+-- Headers such as ETag and Location of stored idempotent responses, replayed
+-- with them
+ALTER TABLE idempotency_keys ADD COLUMN response_headers JSONB;
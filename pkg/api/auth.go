@@ -0,0 +1,243 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// accessError reports why a request may not proceed and the status code to
+// answer with.
+type accessError struct {
+	status  int
+	message string
+}
+
+func (e *accessError) Error() string {
+	return e.message
+}
+
+var errForbidden = &accessError{http.StatusForbidden, "You do not have permission to do this"}
+
+// writeAccessError answers a request whose authorization check failed.
+func writeAccessError(w http.ResponseWriter, err error, failure string) {
+	var accessErr *accessError
+	if errors.As(err, &accessErr) {
+		http.Error(w, accessErr.message, accessErr.status)
+		return
+	}
+	fmt.Printf("Error checking permissions: %v\n", err)
+	http.Error(w, failure, http.StatusInternalServerError)
+}
+
+var errUnauthenticated = &accessError{http.StatusUnauthorized, "Authentication required"}
+
+// currentUserID returns the user making the request. The API does not
+// authenticate anyone itself: the X-User-ID header is only believed when
+// TRUST_USER_HEADER=true says an authenticating proxy in front of the server
+// sets it. Requests without a trusted header act as DEFAULT_USER_ID when it
+// is set, so single-user setups work without a proxy, and are refused
+// otherwise.
+func currentUserID(r *http.Request) (int, error) {
+	value := r.Header.Get("X-User-ID")
+	if value != "" && os.Getenv("TRUST_USER_HEADER") != "true" {
+		return 0, &accessError{http.StatusUnauthorized, "X-User-ID header is not trusted by this server"}
+	}
+	if value == "" {
+		value = os.Getenv("DEFAULT_USER_ID")
+	}
+	if value == "" {
+		return 0, errUnauthenticated
+	}
+
+	userID, err := strconv.Atoi(value)
+	if err != nil || userID <= 0 {
+		return 0, &accessError{http.StatusUnauthorized, "Invalid X-User-ID header"}
+	}
+	return userID, nil
+}
+
+// workspaceRole returns the role of the user in the workspace, or an empty
+// string when the user is not a member.
+func workspaceRole(q sqlx.Queryer, userID, workspaceID int) (string, error) {
+	var role string
+	err := sqlx.Get(q, &role, "SELECT role FROM workspace_members WHERE workspace_id = $1 AND user_id = $2", workspaceID, userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return role, err
+}
+
+// authorizeWorkspace checks that the requesting user holds at least minRole
+// in the workspace and returns the user's ID.
+func authorizeWorkspace(q sqlx.Queryer, r *http.Request, workspaceID int, minRole string) (int, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, err
+	}
+
+	role, err := workspaceRole(q, userID, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+	if role == "" {
+		return 0, &accessError{http.StatusNotFound, "Workspace not found"}
+	}
+	if !models.RoleAtLeast(role, minRole) {
+		return 0, errForbidden
+	}
+	return userID, nil
+}
+
+// currentWorkspace returns the workspace a request acts in: the one named by
+// the X-Workspace-ID header, else the requesting user's oldest workspace. The
+// user must hold at least minRole in it.
+func currentWorkspace(q sqlx.Queryer, r *http.Request, minRole string) (int, error) {
+	if value := r.Header.Get("X-Workspace-ID"); value != "" {
+		workspaceID, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, &accessError{http.StatusBadRequest, "Invalid X-Workspace-ID header"}
+		}
+		if _, err := authorizeWorkspace(q, r, workspaceID, minRole); err != nil {
+			return 0, err
+		}
+		return workspaceID, nil
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var workspaceID int
+	err = sqlx.Get(q, &workspaceID, "SELECT workspace_id FROM workspace_members WHERE user_id = $1 ORDER BY workspace_id LIMIT 1", userID)
+	if err == sql.ErrNoRows {
+		return 0, &accessError{http.StatusForbidden, "You are not a member of any workspace"}
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := authorizeWorkspace(q, r, workspaceID, minRole); err != nil {
+		return 0, err
+	}
+	return workspaceID, nil
+}
+
+// authorizeProject checks that the requesting user holds at least minRole in
+// the workspace of the project and returns the user's ID and role.
+func authorizeProject(q sqlx.Queryer, r *http.Request, projectID interface{}, minRole string) (int, string, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var role sql.NullString
+	err = sqlx.Get(q, &role, `SELECT m.role FROM projects p
+		LEFT JOIN workspace_members m ON m.workspace_id = p.workspace_id AND m.user_id = $2
+		WHERE p.id = $1`, projectID, userID)
+	if err == sql.ErrNoRows || (err == nil && !role.Valid) {
+		return 0, "", &accessError{http.StatusNotFound, "Project not found"}
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	if !models.RoleAtLeast(role.String, minRole) {
+		return 0, "", errForbidden
+	}
+	return userID, role.String, nil
+}
+
+// authorizeClient checks that the requesting user holds at least minRole in
+// the workspace of the client.
+func authorizeClient(q sqlx.Queryer, r *http.Request, clientID interface{}, minRole string) error {
+	var workspaceID int
+	err := sqlx.Get(q, &workspaceID, "SELECT workspace_id FROM clients WHERE id = $1", clientID)
+	if err == sql.ErrNoRows {
+		return &accessError{http.StatusNotFound, "Client not found"}
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = authorizeWorkspace(q, r, workspaceID, minRole)
+	var accessErr *accessError
+	if errors.As(err, &accessErr) && accessErr.status == http.StatusNotFound {
+		return &accessError{http.StatusNotFound, "Client not found"}
+	}
+	return err
+}
+
+// authorizeEntryWrite checks that the requesting user may log time for
+// entryUserID on the project. Members log their own time on projects they
+// are assigned to; admins and owners log time for anyone.
+func authorizeEntryWrite(q sqlx.Queryer, r *http.Request, projectID, entryUserID int) error {
+	userID, role, err := authorizeProject(q, r, projectID, models.RoleMember)
+	if err != nil {
+		return err
+	}
+	if models.RoleAtLeast(role, models.RoleAdmin) {
+		return nil
+	}
+	if entryUserID != userID {
+		return &accessError{http.StatusForbidden, "Members can only log their own time"}
+	}
+
+	var assigned bool
+	err = sqlx.Get(q, &assigned, "SELECT EXISTS (SELECT 1 FROM project_members WHERE project_id = $1 AND user_id = $2)", projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return &accessError{http.StatusForbidden, "You are not assigned to this project"}
+	}
+	return nil
+}
+
+// authorizeUser checks that the requesting user may act on the time of
+// targetUserID: users act on their own time, and admins and owners on the
+// time of the members of their workspaces. It returns the requesting user.
+func authorizeUser(q sqlx.Queryer, r *http.Request, targetUserID int) (int, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, err
+	}
+	if userID == targetUserID {
+		return userID, nil
+	}
+	return authorizeReviewer(q, r, targetUserID)
+}
+
+// authorizeReviewer checks that the requesting user is an admin or owner of
+// a workspace targetUserID is a member of. It returns the requesting user.
+func authorizeReviewer(q sqlx.Queryer, r *http.Request, targetUserID int) (int, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var allowed bool
+	err = sqlx.Get(q, &allowed, `SELECT EXISTS (
+		SELECT 1 FROM workspace_members me
+		JOIN workspace_members target ON target.workspace_id = me.workspace_id
+		WHERE me.user_id = $1 AND me.role IN ('owner', 'admin') AND target.user_id = $2)`, userID, targetUserID)
+	if err != nil {
+		return 0, err
+	}
+	if !allowed {
+		return 0, errForbidden
+	}
+	return userID, nil
+}
+
+// memberWorkspacesClause restricts a query to the workspaces of the user
+// bound to the given placeholder.
+func memberWorkspacesClause(column string, argIndex int) string {
+	return fmt.Sprintf("%s IN (SELECT workspace_id FROM workspace_members WHERE user_id = $%d)", column, argIndex)
+}
@@ -0,0 +1,287 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"side-sync/pkg/models"
+)
+
+const brandingColumns = "id, workspace_id, client_id, company_name, company_address, primary_color, footer_text, logo, logo_content_type, created_at, updated_at"
+
+const maxLogoSize = 2 << 20
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *Server) GetBranding(w http.ResponseWriter, r *http.Request) {
+	clientID, err := brandingClientID(r)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	workspaceID, err := s.authorizeBranding(r, clientID, models.RoleViewer)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch branding")
+		return
+	}
+
+	var branding models.Branding
+	if r.URL.Query().Get("effective") == "true" {
+		branding, err = s.resolveBranding(workspaceID, clientID)
+	} else {
+		branding, err = s.getBranding(workspaceID, clientID)
+		if err == sql.ErrNoRows {
+			branding, err = models.Branding{WorkspaceID: workspaceID, ClientID: clientID}, nil
+		}
+	}
+	if err != nil {
+		fmt.Printf("Error fetching branding: %v\n", err)
+		http.Error(w, "Failed to fetch branding", http.StatusInternalServerError)
+		return
+	}
+	branding.HasLogo = len(branding.Logo) > 0
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(branding)
+}
+
+func (s *Server) UpdateBranding(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := brandingClientID(r)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	workspaceID, err := s.authorizeBranding(r, clientID, models.RoleAdmin)
+	if err != nil {
+		writeAccessError(w, err, "Failed to update branding")
+		return
+	}
+
+	var branding models.Branding
+	if err := json.NewDecoder(r.Body).Decode(&branding); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if branding.PrimaryColor != "" && !hexColorPattern.MatchString(branding.PrimaryColor) {
+		http.Error(w, "Primary color must be a hex color like #3498db", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.ensureBranding(workspaceID, clientID); err != nil {
+		fmt.Printf("Error creating branding: %v\n", err)
+		http.Error(w, "Failed to update branding", http.StatusInternalServerError)
+		return
+	}
+
+	query := `UPDATE branding SET company_name = $1, company_address = $2, primary_color = $3, footer_text = $4, updated_at = NOW() WHERE workspace_id = $5 AND client_id IS NOT DISTINCT FROM $6 RETURNING ` + brandingColumns
+	err = s.db.QueryRowx(query, branding.CompanyName, branding.CompanyAddress, branding.PrimaryColor, branding.FooterText, workspaceID, clientID).StructScan(&branding)
+	if err != nil {
+		fmt.Printf("Error updating branding: %v\n", err)
+		http.Error(w, "Failed to update branding", http.StatusInternalServerError)
+		return
+	}
+	branding.HasLogo = len(branding.Logo) > 0
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(branding)
+}
+
+func (s *Server) GetBrandingLogo(w http.ResponseWriter, r *http.Request) {
+	clientID, err := brandingClientID(r)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	workspaceID, err := s.authorizeBranding(r, clientID, models.RoleViewer)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch logo")
+		return
+	}
+
+	branding, err := s.getBranding(workspaceID, clientID)
+	if err != nil || len(branding.Logo) == 0 {
+		http.Error(w, "Logo not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", branding.LogoContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(branding.Logo)))
+	w.Write(branding.Logo)
+}
+
+func (s *Server) UploadBrandingLogo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := brandingClientID(r)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	workspaceID, err := s.authorizeBranding(r, clientID, models.RoleAdmin)
+	if err != nil {
+		writeAccessError(w, err, "Failed to upload logo")
+		return
+	}
+
+	if err := r.ParseMultipartForm(maxLogoSize); err != nil {
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("logo")
+	if err != nil {
+		http.Error(w, "Failed to get uploaded file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	logo, err := io.ReadAll(io.LimitReader(file, maxLogoSize+1))
+	if err != nil {
+		http.Error(w, "Failed to read uploaded file", http.StatusBadRequest)
+		return
+	}
+	if len(logo) > maxLogoSize {
+		http.Error(w, "Logo must be smaller than 2 MB", http.StatusBadRequest)
+		return
+	}
+
+	contentType := http.DetectContentType(logo)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		http.Error(w, "Logo must be a PNG or JPEG image", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.ensureBranding(workspaceID, clientID); err != nil {
+		fmt.Printf("Error creating branding: %v\n", err)
+		http.Error(w, "Failed to upload logo", http.StatusInternalServerError)
+		return
+	}
+
+	_, err = s.db.Exec(`UPDATE branding SET logo = $1, logo_content_type = $2, updated_at = NOW() WHERE workspace_id = $3 AND client_id IS NOT DISTINCT FROM $4`, logo, contentType, workspaceID, clientID)
+	if err != nil {
+		fmt.Printf("Error uploading logo: %v\n", err)
+		http.Error(w, "Failed to upload logo", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":      true,
+		"content_type": contentType,
+		"size":         len(logo),
+	})
+}
+
+func (s *Server) DeleteBrandingLogo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, err := brandingClientID(r)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	workspaceID, err := s.authorizeBranding(r, clientID, models.RoleAdmin)
+	if err != nil {
+		writeAccessError(w, err, "Failed to delete logo")
+		return
+	}
+
+	_, err = s.db.Exec(`UPDATE branding SET logo = NULL, logo_content_type = '', updated_at = NOW() WHERE workspace_id = $1 AND client_id IS NOT DISTINCT FROM $2`, workspaceID, clientID)
+	if err != nil {
+		fmt.Printf("Error deleting logo: %v\n", err)
+		http.Error(w, "Failed to delete logo", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Logo deleted",
+	})
+}
+
+func (s *Server) getBranding(workspaceID int, clientID *int) (models.Branding, error) {
+	var branding models.Branding
+	err := s.db.Get(&branding, "SELECT "+brandingColumns+" FROM branding WHERE workspace_id = $1 AND client_id IS NOT DISTINCT FROM $2", workspaceID, clientID)
+	return branding, err
+}
+
+func (s *Server) ensureBranding(workspaceID int, clientID *int) error {
+	_, err := s.db.Exec(`INSERT INTO branding (workspace_id, client_id) SELECT $1, $2::INTEGER WHERE NOT EXISTS (SELECT 1 FROM branding WHERE workspace_id = $1 AND client_id IS NOT DISTINCT FROM $2::INTEGER)`, workspaceID, clientID)
+	return err
+}
+
+// resolveBranding returns the branding for a client's reports, falling back
+// to the workspace's default branding for every field the client does not
+// override.
+func (s *Server) resolveBranding(workspaceID int, clientID *int) (models.Branding, error) {
+	defaults, err := s.getBranding(workspaceID, nil)
+	if err != nil && err != sql.ErrNoRows {
+		return models.Branding{}, err
+	}
+
+	if clientID == nil {
+		return defaults.Merge(models.Branding{}), nil
+	}
+
+	override, err := s.getBranding(workspaceID, clientID)
+	if err == sql.ErrNoRows {
+		return defaults.Merge(models.Branding{}), nil
+	}
+	if err != nil {
+		return models.Branding{}, err
+	}
+
+	return override.Merge(defaults), nil
+}
+
+func brandingClientID(r *http.Request) (*int, error) {
+	value := r.URL.Query().Get("client_id")
+	if value == "" {
+		return nil, nil
+	}
+
+	clientID, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &clientID, nil
+}
+
+// authorizeBranding checks that the requesting user holds at least minRole
+// in the client's workspace, or in the current workspace for the default
+// branding, and returns that workspace.
+func (s *Server) authorizeBranding(r *http.Request, clientID *int, minRole string) (int, error) {
+	if clientID == nil {
+		return currentWorkspace(s.db, r, minRole)
+	}
+
+	if err := authorizeClient(s.db, r, *clientID, minRole); err != nil {
+		return 0, err
+	}
+	var workspaceID int
+	err := s.db.Get(&workspaceID, "SELECT workspace_id FROM clients WHERE id = $1", *clientID)
+	return workspaceID, err
+}
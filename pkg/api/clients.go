@@ -0,0 +1,195 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"side-sync/pkg/models"
+	"side-sync/pkg/pdf"
+)
+
+const clientColumns = "id, name, user_id, workspace_id, locale, rounding_mode, rounding_minutes, rounding_scope, created_at, updated_at"
+
+func (s *Server) GetClients(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch clients")
+		return
+	}
+
+	var clients []models.Client
+	err = s.db.Select(&clients, "SELECT "+clientColumns+" FROM clients WHERE "+memberWorkspacesClause("workspace_id", 1)+" ORDER BY name ASC", userID)
+	if err != nil {
+		fmt.Printf("Error fetching clients: %v\n", err)
+		http.Error(w, "Failed to fetch clients", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(clients)
+}
+
+func (s *Server) CreateClient(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var client models.Client
+	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if client.Name == "" {
+		http.Error(w, "Client name is required", http.StatusBadRequest)
+		return
+	}
+
+	if client.Locale != "" && !pdf.IsSupportedLocale(client.Locale) {
+		http.Error(w, "Unsupported locale", http.StatusBadRequest)
+		return
+	}
+
+	if err := validateRounding(client.RoundingMode, client.RoundingMinutes, &client.RoundingScope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var err error
+	if client.WorkspaceID == 0 {
+		client.WorkspaceID, err = currentWorkspace(s.db, r, models.RoleAdmin)
+		if err != nil {
+			writeAccessError(w, err, "Failed to create client")
+			return
+		}
+	}
+
+	userID, err := authorizeWorkspace(s.db, r, client.WorkspaceID, models.RoleAdmin)
+	if err != nil {
+		writeAccessError(w, err, "Failed to create client")
+		return
+	}
+	if client.UserID == 0 {
+		client.UserID = userID
+	}
+
+	query := `INSERT INTO clients (name, user_id, workspace_id, locale, rounding_mode, rounding_minutes, rounding_scope) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`
+	err = s.db.QueryRow(query, client.Name, client.UserID, client.WorkspaceID, client.Locale, client.RoundingMode, client.RoundingMinutes, client.RoundingScope).Scan(&client.ID, &client.CreatedAt, &client.UpdatedAt)
+	if err != nil {
+		fmt.Printf("Error creating client: %v\n", err)
+		http.Error(w, "Failed to create client", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(client)
+}
+
+func (s *Server) GetClient(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID := r.URL.Query().Get("id")
+	if clientID == "" {
+		http.Error(w, "Client ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := authorizeClient(s.db, r, clientID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch client")
+		return
+	}
+
+	var client models.Client
+	query := "SELECT " + clientColumns + " FROM clients WHERE id = $1"
+	err := s.db.Get(&client, query, clientID)
+	if err != nil {
+		fmt.Printf("Error fetching client: %v\n", err)
+		http.Error(w, "Client not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(client)
+}
+
+func (s *Server) UpdateClient(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID := r.URL.Query().Get("id")
+	if clientID == "" {
+		http.Error(w, "Client ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := authorizeClient(s.db, r, clientID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to update client")
+		return
+	}
+
+	var client models.Client
+	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if client.Locale != "" && !pdf.IsSupportedLocale(client.Locale) {
+		http.Error(w, "Unsupported locale", http.StatusBadRequest)
+		return
+	}
+
+	if err := validateRounding(client.RoundingMode, client.RoundingMinutes, &client.RoundingScope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := `UPDATE clients SET name = $1, locale = $2, rounding_mode = $3, rounding_minutes = $4, rounding_scope = $5, updated_at = NOW() WHERE id = $6 RETURNING id, user_id, workspace_id, created_at, updated_at`
+	err := s.db.QueryRow(query, client.Name, client.Locale, client.RoundingMode, client.RoundingMinutes, client.RoundingScope, clientID).Scan(&client.ID, &client.UserID, &client.WorkspaceID, &client.CreatedAt, &client.UpdatedAt)
+	if err != nil {
+		fmt.Printf("Error updating client: %v\n", err)
+		http.Error(w, "Failed to update client", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(client)
+}
+
+func (s *Server) DeleteClient(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID := r.URL.Query().Get("id")
+	if clientID == "" {
+		http.Error(w, "Client ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := authorizeClient(s.db, r, clientID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to delete client")
+		return
+	}
+
+	_, err := s.db.Exec(`DELETE FROM clients WHERE id = $1`, clientID)
+	if err != nil {
+		fmt.Printf("Error deleting client: %v\n", err)
+		http.Error(w, "Failed to delete client", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Client deleted",
+	})
+}
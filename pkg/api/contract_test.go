@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"go/ast"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+// loadSpec parses and validates openapi.json.
+func loadSpec(t *testing.T) *openapi3.T {
+	t.Helper()
+
+	doc, err := openapi3.NewLoader().LoadFromData(openAPISpec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("openapi.json is invalid: %v", err)
+	}
+	return doc
+}
+
+// checkResponse validates a recorded response against the operation the spec
+// documents for method and path: its status, headers and body. Every header
+// the spec lists for the response must be set.
+func checkResponse(t *testing.T, doc *openapi3.T, method, path string, req *http.Request, rec *httptest.ResponseRecorder) {
+	t.Helper()
+
+	item := doc.Paths.Value(path)
+	if item == nil || item.GetOperation(method) == nil {
+		t.Fatalf("openapi.json does not document %s %s", method, path)
+	}
+	route := &routers.Route{Spec: doc, Path: path, PathItem: item, Method: method, Operation: item.GetOperation(method)}
+	options := &openapi3filter.Options{IncludeResponseStatus: true}
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{Request: req, Route: route, Options: options},
+		Status:                 rec.Code,
+		Header:                 rec.Header(),
+		Options:                options,
+	}
+	input.SetBodyBytes(rec.Body.Bytes())
+	if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+		t.Errorf("%s %s: response does not match openapi.json: %v", method, path, err)
+	}
+
+	response := route.Operation.Responses.Status(rec.Code)
+	if response == nil {
+		response = route.Operation.Responses.Default()
+	}
+	for name := range response.Value.Headers {
+		if rec.Header().Get(name) == "" {
+			t.Errorf("%s %s: response %d has no %s header", method, path, rec.Code, name)
+		}
+	}
+}
+
+func TestHandlersMatchOpenAPI(t *testing.T) {
+	t.Setenv("TRUST_USER_HEADER", "true")
+	t.Setenv("DEFAULT_USER_ID", "")
+
+	doc := loadSpec(t)
+	mux := (&Server{}).SetupRoutes()
+
+	tests := []struct {
+		name, method, target, body string
+		header                     map[string]string
+		path                       string // as in openapi.json
+		status                     int
+	}{
+		{name: "spec", method: "GET", target: "/api/openapi.json", path: "/api/openapi.json", status: http.StatusOK},
+		{name: "currencies", method: "GET", target: "/api/v1/currencies", path: "/api/v1/currencies", status: http.StatusOK},
+		{name: "legacy currencies", method: "GET", target: "/api/currencies", path: "/api/currencies", status: http.StatusOK},
+		{name: "unauthenticated", method: "GET", target: "/api/v1/projects", path: "/api/v1/projects", status: http.StatusUnauthorized},
+		{name: "untrusted user header", method: "GET", target: "/api/v1/time-entries", path: "/api/v1/time-entries", header: map[string]string{"X-User-ID": "x"}, status: http.StatusUnauthorized},
+		{name: "long idempotency key", method: "POST", target: "/api/v1/projects", body: `{"name": "Website"}`,
+			header: map[string]string{"X-User-ID": "1", "Idempotency-Key": strings.Repeat("k", 256)}, path: "/api/v1/projects", status: http.StatusBadRequest},
+		{name: "merge duplicates", method: "POST", target: "/api/v1/time-entries/merge", body: `{"ids": [4, 5, 4]}`,
+			header: map[string]string{"X-User-ID": "1"}, path: "/api/v1/time-entries/merge", status: http.StatusBadRequest},
+		{name: "legacy merge duplicates", method: "POST", target: "/api/time-entries/merge", body: `{"ids": [4, 4]}`,
+			header: map[string]string{"X-User-ID": "1"}, path: "/api/time-entries/merge", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.status, rec.Body)
+			}
+			checkResponse(t, doc, tt.method, tt.path, req, rec)
+		})
+	}
+}
+
+func TestETagResponsesMatchOpenAPI(t *testing.T) {
+	doc := loadSpec(t)
+
+	clientID := 3
+	rate := 80.0
+	end := time.Date(2024, 3, 4, 11, 30, 0, 0, time.UTC)
+	duration := 5400
+	created := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name, method, path string
+		value              interface{}
+	}{
+		{"project", "GET", "/api/v1/projects/{id}", models.Project{ID: 1, Name: "Website", UserID: 1, WorkspaceID: 1, RoundingScope: "entry", CreatedAt: created, UpdatedAt: created}},
+		{"project with client", "PUT", "/api/v1/projects/{id}", models.Project{ID: 1, Name: "Website", UserID: 1, WorkspaceID: 1, ClientID: &clientID, HourlyRate: &rate, RoundingScope: "entry", CreatedAt: created, UpdatedAt: created}},
+		{"running time entry", "GET", "/api/v1/time-entries/{id}", models.TimeEntry{ID: 2, ProjectID: 1, UserID: 1, StartTime: created, CreatedAt: created, UpdatedAt: created}},
+		{"time entry", "PATCH", "/api/v1/time-entries/{id}", models.TimeEntry{ID: 2, ProjectID: 1, UserID: 1, StartTime: end.Add(-90 * time.Minute), EndTime: &end, Duration: &duration, Billable: true, Tags: []string{"design"}, CreatedAt: created, UpdatedAt: created}},
+		{"legacy time entry", "GET", "/api/time-entries/single", models.TimeEntry{ID: 2, ProjectID: 1, UserID: 1, StartTime: created, CreatedAt: created, UpdatedAt: created}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			rec := httptest.NewRecorder()
+			handler := func(w http.ResponseWriter, r *http.Request) { writeWithETag(w, tt.value) }
+			if !strings.HasPrefix(tt.path, "/api/v1/") {
+				deprecated(http.HandlerFunc(handler)).ServeHTTP(rec, req)
+			} else {
+				handler(rec, req)
+			}
+
+			checkResponse(t, doc, tt.method, tt.path, req, rec)
+		})
+	}
+}
+
+// TestIdempotentRoutesDocumentIdempotencyKey checks that the operations of
+// routes wrapped in s.idempotent take the Idempotency-Key header.
+func TestIdempotentRoutesDocumentIdempotencyKey(t *testing.T) {
+	doc := loadSpec(t)
+
+	var routes []string
+	ast.Inspect(funcBody(t, routeSource(t), "setupV1Routes"), func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		wrapper, ok := call.Args[1].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := wrapper.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "idempotent" {
+			pattern, _ := stringArg(t, call.Args[0])
+			routes = append(routes, pattern)
+		}
+		return true
+	})
+	if len(routes) == 0 {
+		t.Fatal("no idempotent routes found in setupV1Routes")
+	}
+
+	for _, route := range routes {
+		method, path, _ := strings.Cut(route, " ")
+		for _, path := range []string{path, "/api" + strings.TrimPrefix(path, "/api/v1")} {
+			operation := doc.Paths.Value(path).GetOperation(method)
+			if operation.Parameters.GetByInAndName(openapi3.ParameterInHeader, "Idempotency-Key") == nil {
+				t.Errorf("%s %s does not document the Idempotency-Key header", method, path)
+			}
+		}
+	}
+}
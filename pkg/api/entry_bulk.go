@@ -0,0 +1,282 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const maxBulkEntries = 1000
+
+// bulkSelection names the time entries of a bulk request, either by ID or by
+// a filter over the entries of the requesting user's workspaces.
+type bulkSelection struct {
+	IDs    []int       `json:"ids"`
+	Filter *bulkFilter `json:"filter"`
+}
+
+type bulkFilter struct {
+	ProjectID int    `json:"project_id"`
+	ClientID  int    `json:"client_id"`
+	UserID    int    `json:"user_id"`
+	DateFrom  string `json:"date_from"`
+	DateTo    string `json:"date_to"`
+	Billable  *bool  `json:"billable"`
+}
+
+// bulkUpdate holds the fields a bulk update changes; omitted fields are kept.
+type bulkUpdate struct {
+	ProjectID    *int      `json:"project_id"`
+	Billable     *bool     `json:"billable"`
+	Description  *string   `json:"description"`
+	Tags         *[]string `json:"tags"`
+	ShiftMinutes int       `json:"shift_minutes"`
+}
+
+// BulkUpdateTimeEntries applies one partial update to many time entries in a
+// single transaction. Entries that cannot be changed are reported and left
+// as they were; the others are updated.
+func (s *Server) BulkUpdateTimeEntries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var requestBody struct {
+		bulkSelection
+		Update bulkUpdate `json:"update"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	update := requestBody.Update
+	if update.ProjectID == nil && update.Billable == nil && update.Description == nil && update.Tags == nil && update.ShiftMinutes == 0 {
+		http.Error(w, "update must change at least one field", http.StatusBadRequest)
+		return
+	}
+
+	s.runBulk(w, r, requestBody.bulkSelection, "Failed to update time entries", func(tx *sqlx.Tx, entry models.TimeEntry) (string, error) {
+		changed := entry
+		if update.ProjectID != nil {
+			changed.ProjectID = *update.ProjectID
+		}
+		if update.Billable != nil {
+			changed.Billable = *update.Billable
+		}
+		if update.Description != nil {
+			changed.Description = *update.Description
+		}
+		if update.ShiftMinutes != 0 {
+			shift := time.Duration(update.ShiftMinutes) * time.Minute
+			changed.StartTime = entry.StartTime.Add(shift)
+			if entry.EndTime != nil {
+				end := entry.EndTime.Add(shift)
+				changed.EndTime = &end
+			}
+		}
+
+		if err := s.checkEntryLock(tx, r, changed.UserID, changed.ProjectID, changed.StartTime); err != nil {
+			return "", err
+		}
+
+		query := `UPDATE time_entries SET project_id = $1, description = $2, start_time = $3, end_time = $4, billable = $5, updated_at = NOW() WHERE id = $6`
+		if _, err := tx.Exec(query, changed.ProjectID, changed.Description, changed.StartTime, changed.EndTime, changed.Billable, entry.ID); err != nil {
+			return "", err
+		}
+		if update.Tags != nil {
+			if _, err := setTimeEntryTags(tx, entry.ID, *update.Tags); err != nil {
+				return "", err
+			}
+		}
+		return models.BulkUpdated, nil
+	})
+}
+
+// BulkDeleteTimeEntries deletes many time entries in a single transaction.
+// Entries that cannot be deleted are reported and kept.
+func (s *Server) BulkDeleteTimeEntries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var selection bulkSelection
+	if err := json.NewDecoder(r.Body).Decode(&selection); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	s.runBulk(w, r, selection, "Failed to delete time entries", func(tx *sqlx.Tx, entry models.TimeEntry) (string, error) {
+		if _, err := tx.Exec("DELETE FROM time_entries WHERE id = $1", entry.ID); err != nil {
+			return "", err
+		}
+		return models.BulkDeleted, nil
+	})
+}
+
+// runBulk resolves the selection and applies apply to every entry inside a
+// savepoint of one transaction, after checking that the requesting user may
+// change the entry as it is. A failing entry is rolled back to its savepoint
+// and reported; the rest are committed together.
+func (s *Server) runBulk(w http.ResponseWriter, r *http.Request, selection bulkSelection, failure string, apply func(tx *sqlx.Tx, entry models.TimeEntry) (string, error)) {
+	ids, err := s.resolveBulkSelection(r, selection)
+	if err != nil {
+		writeAccessError(w, err, failure)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, failure, http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	results := make([]models.BulkResult, 0, len(ids))
+	failed := 0
+	for _, id := range ids {
+		if _, err := tx.Exec("SAVEPOINT bulk_entry"); err != nil {
+			fmt.Printf("Error creating savepoint: %v\n", err)
+			http.Error(w, failure, http.StatusInternalServerError)
+			return
+		}
+
+		result := models.BulkResult{ID: id}
+		var entry models.TimeEntry
+		err := tx.Get(&entry, "SELECT "+timeEntryColumns+" FROM time_entries WHERE id = $1 FOR UPDATE", id)
+		if err == nil {
+			err = s.checkEntryLock(tx, r, entry.UserID, entry.ProjectID, entry.StartTime)
+		}
+		if err == nil {
+			result.Status, err = apply(tx, entry)
+		}
+
+		var accessErr *accessError
+		switch {
+		case err == nil:
+			_, err = tx.Exec("RELEASE SAVEPOINT bulk_entry")
+		case err == sql.ErrNoRows:
+			result.Status = models.BulkNotFound
+			result.Error = "Time entry not found"
+			_, err = tx.Exec("ROLLBACK TO SAVEPOINT bulk_entry")
+		case errors.As(err, &accessErr):
+			result.Status = models.BulkFailed
+			result.Error = accessErr.message
+			_, err = tx.Exec("ROLLBACK TO SAVEPOINT bulk_entry")
+		default:
+			fmt.Printf("Error in bulk operation on time entry %d: %v\n", id, err)
+			result.Status = models.BulkFailed
+			result.Error = failure
+			_, err = tx.Exec("ROLLBACK TO SAVEPOINT bulk_entry")
+		}
+		if err != nil {
+			fmt.Printf("Error releasing savepoint: %v\n", err)
+			http.Error(w, failure, http.StatusInternalServerError)
+			return
+		}
+
+		if result.Error != "" {
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	if err := tx.Commit(); err != nil {
+		fmt.Printf("Error committing bulk operation: %v\n", err)
+		http.Error(w, failure, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":   failed == 0,
+		"processed": len(ids) - failed,
+		"failed":    failed,
+		"results":   results,
+	})
+}
+
+// resolveBulkSelection returns the IDs of the selected entries. IDs are taken
+// as given so unknown ones can be reported; a filter only matches entries in
+// the requesting user's workspaces, with dates in the user's timezone. Invalid
+// selections are reported as an *accessError with status 400.
+func (s *Server) resolveBulkSelection(r *http.Request, selection bulkSelection) ([]int, error) {
+	if (len(selection.IDs) == 0) == (selection.Filter == nil) {
+		return nil, &accessError{http.StatusBadRequest, "Either ids or filter is required"}
+	}
+
+	ids := selection.IDs
+	if filter := selection.Filter; filter != nil {
+		if *filter == (bulkFilter{}) {
+			return nil, &accessError{http.StatusBadRequest, "filter must set at least one field"}
+		}
+
+		userID, err := currentUserID(r)
+		if err != nil {
+			return nil, err
+		}
+		loc, err := userLocation(s.db, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		query := "SELECT te.id FROM time_entries te JOIN projects p ON p.id = te.project_id WHERE " + memberWorkspacesClause("p.workspace_id", 1)
+		args := []interface{}{userID}
+		argIndex := 2
+
+		if filter.ProjectID != 0 {
+			query += fmt.Sprintf(" AND te.project_id = $%d", argIndex)
+			args = append(args, filter.ProjectID)
+			argIndex++
+		}
+
+		if filter.ClientID != 0 {
+			query += fmt.Sprintf(" AND p.client_id = $%d", argIndex)
+			args = append(args, filter.ClientID)
+			argIndex++
+		}
+
+		if filter.UserID != 0 {
+			query += fmt.Sprintf(" AND te.user_id = $%d", argIndex)
+			args = append(args, filter.UserID)
+			argIndex++
+		}
+
+		if filter.DateFrom != "" {
+			query += fmt.Sprintf(" AND DATE(te.start_time AT TIME ZONE $%d) >= $%d", argIndex, argIndex+1)
+			args = append(args, loc.String(), filter.DateFrom)
+			argIndex += 2
+		}
+
+		if filter.DateTo != "" {
+			query += fmt.Sprintf(" AND DATE(te.start_time AT TIME ZONE $%d) <= $%d", argIndex, argIndex+1)
+			args = append(args, loc.String(), filter.DateTo)
+			argIndex += 2
+		}
+
+		if filter.Billable != nil {
+			query += fmt.Sprintf(" AND te.billable = $%d", argIndex)
+			args = append(args, *filter.Billable)
+		}
+
+		query += " ORDER BY te.start_time ASC, te.id ASC"
+		if err := s.db.Select(&ids, query, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(ids) > maxBulkEntries {
+		return nil, &accessError{http.StatusBadRequest, fmt.Sprintf("A bulk request can change at most %d time entries", maxBulkEntries)}
+	}
+	return ids, nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// checkEntryLock returns an *accessError when time entries of the user and
+// project starting at startTime may not be created, changed or deleted by the
+// requesting user, because they lack permission, the timesheet week is
+// approved or the date is locked. Weeks and dates are those of startTime in
+// the user's timezone. Admins can override the date lock with
+// override_lock=true.
+func (s *Server) checkEntryLock(q sqlx.Queryer, r *http.Request, userID, projectID int, startTime time.Time) error {
+	if err := authorizeEntryWrite(q, r, projectID, userID); err != nil {
+		return err
+	}
+
+	loc, err := userLocation(q, userID)
+	if err != nil {
+		return err
+	}
+	local := startTime.In(loc)
+
+	approved, err := weekApproved(q, userID, local, 1)
+	if err != nil {
+		return err
+	}
+	if approved {
+		return &accessError{http.StatusConflict, approvedPeriodMessage}
+	}
+
+	var lockedUntil sql.NullString
+	err = sqlx.Get(q, &lockedUntil, `SELECT TO_CHAR(COALESCE(p.locked_until, s.locked_until), 'YYYY-MM-DD')
+		FROM projects p
+		LEFT JOIN settings s ON s.workspace_id = p.workspace_id
+		WHERE p.id = $1 AND $2::DATE <= COALESCE(p.locked_until, s.locked_until)`, projectID, local.Format("2006-01-02"))
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.URL.Query().Get("override_lock") == "true" {
+		_, _, err := authorizeProject(q, r, projectID, models.RoleAdmin)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errForbidden) {
+			return err
+		}
+		return &accessError{http.StatusForbidden, "Only admins can override the period lock"}
+	}
+
+	return &accessError{http.StatusConflict, fmt.Sprintf("Time entries dated on or before %s are locked", lockedUntil.String)}
+}
+
+// checkStoredEntryLock runs checkEntryLock for an existing time entry. It
+// returns sql.ErrNoRows when the entry does not exist.
+func (s *Server) checkStoredEntryLock(q sqlx.Queryer, r *http.Request, timeEntryID string) error {
+	var entry models.TimeEntry
+	if err := sqlx.Get(q, &entry, "SELECT id, project_id, user_id, start_time FROM time_entries WHERE id = $1", timeEntryID); err != nil {
+		return err
+	}
+	return s.checkEntryLock(q, r, entry.UserID, entry.ProjectID, entry.StartTime)
+}
+
+// writeLockError answers a request whose lock check failed.
+func writeLockError(w http.ResponseWriter, err error, failure string) {
+	var accessErr *accessError
+	switch {
+	case errors.As(err, &accessErr):
+		http.Error(w, accessErr.message, accessErr.status)
+	case err == sql.ErrNoRows:
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+	default:
+		fmt.Printf("Error checking time entry lock: %v\n", err)
+		http.Error(w, failure, http.StatusInternalServerError)
+	}
+}
+
+func validateLockDate(date *string) error {
+	if date == nil {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", *date); err != nil {
+		return fmt.Errorf("locked_until must be a date in YYYY-MM-DD format")
+	}
+	return nil
+}
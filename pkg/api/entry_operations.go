@@ -0,0 +1,347 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const timeEntryColumns = "id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at"
+
+const maxSplitParts = 100
+
+// SplitTimeEntry cuts a finished time entry at a point in time or into a
+// number of equal parts. One part can be moved to another project on the way.
+func (s *Server) SplitTimeEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var requestBody struct {
+		ID        int        `json:"id"`
+		At        *time.Time `json:"at"`
+		Parts     int        `json:"parts"`
+		MovePart  *int       `json:"move_part"`
+		ProjectID int        `json:"project_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if (requestBody.At == nil) == (requestBody.Parts == 0) {
+		http.Error(w, "Either at or parts is required", http.StatusBadRequest)
+		return
+	}
+	if requestBody.At == nil && (requestBody.Parts < 2 || requestBody.Parts > maxSplitParts) {
+		http.Error(w, fmt.Sprintf("parts must be between 2 and %d", maxSplitParts), http.StatusBadRequest)
+		return
+	}
+	if requestBody.MovePart != nil && requestBody.ProjectID == 0 {
+		http.Error(w, "project_id is required to move a part", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to split time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var entry models.TimeEntry
+	if err := tx.Get(&entry, "SELECT "+timeEntryColumns+" FROM time_entries WHERE id = $1 FOR UPDATE", requestBody.ID); err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+	if err := s.checkOperationAccess(tx, r, entry); err != nil {
+		writeLockError(w, err, "Failed to split time entry")
+		return
+	}
+	if entry.EndTime == nil || entry.Duration == nil {
+		http.Error(w, "Running time entries cannot be split", http.StatusBadRequest)
+		return
+	}
+
+	var parts []models.TimeEntry
+	if requestBody.At != nil {
+		if !requestBody.At.After(entry.StartTime) || !requestBody.At.Before(*entry.EndTime) {
+			http.Error(w, "at must lie between the start and end of the time entry", http.StatusBadRequest)
+			return
+		}
+		parts = models.SplitAt(entry, []time.Time{*requestBody.At})
+	} else {
+		parts = models.SplitInto(entry, requestBody.Parts)
+	}
+
+	if move := requestBody.MovePart; move != nil {
+		if *move < 0 || *move >= len(parts) {
+			http.Error(w, fmt.Sprintf("move_part must be between 0 and %d", len(parts)-1), http.StatusBadRequest)
+			return
+		}
+		parts[*move].ProjectID = requestBody.ProjectID
+	}
+
+	if err := s.storeSplitEntry(tx, r, entry, parts); err != nil {
+		writeLockError(w, err, "Failed to split time entry")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		fmt.Printf("Error splitting time entry: %v\n", err)
+		http.Error(w, "Failed to split time entry", http.StatusInternalServerError)
+		return
+	}
+
+	if err := loadTimeEntryTags(s.db, parts); err != nil {
+		fmt.Printf("Error fetching time entry tags: %v\n", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(parts)
+}
+
+// storeSplitEntry replaces a stored entry with its parts and records the
+// split. The first part keeps the entry's ID; the others are created with
+// the entry's tags. Every part must pass the lock checks for its project.
+func (s *Server) storeSplitEntry(tx *sqlx.Tx, r *http.Request, entry models.TimeEntry, parts []models.TimeEntry) error {
+	for _, part := range parts {
+		if err := s.checkEntryLock(tx, r, part.UserID, part.ProjectID, part.StartTime); err != nil {
+			return err
+		}
+	}
+	if len(parts) == 1 {
+		return nil
+	}
+
+	err := tx.QueryRow("UPDATE time_entries SET project_id = $1, end_time = $2, duration = $3, updated_at = NOW() WHERE id = $4 RETURNING updated_at",
+		parts[0].ProjectID, parts[0].EndTime, parts[0].Duration, entry.ID).Scan(&parts[0].UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(parts); i++ {
+		part := &parts[i]
+		err := tx.QueryRow(`INSERT INTO time_entries (project_id, user_id, description, start_time, end_time, duration, billable) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`,
+			part.ProjectID, part.UserID, part.Description, part.StartTime, part.EndTime, part.Duration, part.Billable).Scan(&part.ID, &part.CreatedAt, &part.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec("INSERT INTO time_entry_tags (time_entry_id, tag_id) SELECT $1, tag_id FROM time_entry_tags WHERE time_entry_id = $2", part.ID, entry.ID); err != nil {
+			return err
+		}
+	}
+
+	return recordEntryHistory(tx, r, models.EntrySplit, []models.TimeEntry{entry}, parts)
+}
+
+// MergeTimeEntries joins adjacent finished entries of the same project, user
+// and billable status into the earliest of them. The merged entry carries the
+// tags of all of them; the others are deleted.
+func (s *Server) MergeTimeEntries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var requestBody struct {
+		IDs         []int   `json:"ids"`
+		Description *string `json:"description"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if len(requestBody.IDs) < 2 {
+		http.Error(w, "At least two time entries are required to merge", http.StatusBadRequest)
+		return
+	}
+	unique := make(map[int]bool, len(requestBody.IDs))
+	for _, id := range requestBody.IDs {
+		if unique[id] {
+			http.Error(w, fmt.Sprintf("Time entry %d appears more than once", id), http.StatusBadRequest)
+			return
+		}
+		unique[id] = true
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to merge time entries", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var entries []models.TimeEntry
+	err = tx.Select(&entries, "SELECT "+timeEntryColumns+" FROM time_entries WHERE id = ANY($1) ORDER BY start_time ASC, id ASC FOR UPDATE", pq.Array(requestBody.IDs))
+	if err != nil {
+		fmt.Printf("Error fetching time entries: %v\n", err)
+		http.Error(w, "Failed to merge time entries", http.StatusInternalServerError)
+		return
+	}
+	for _, entry := range entries {
+		if err := s.checkOperationAccess(tx, r, entry); err != nil {
+			writeLockError(w, err, "Failed to merge time entries")
+			return
+		}
+	}
+	if len(entries) != len(requestBody.IDs) {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
+	first := entries[0]
+	var descriptions []string
+	seen := make(map[string]bool)
+	duration := 0
+	for i, entry := range entries {
+		if entry.EndTime == nil || entry.Duration == nil {
+			http.Error(w, "Running time entries cannot be merged", http.StatusBadRequest)
+			return
+		}
+		if entry.ProjectID != first.ProjectID || entry.UserID != first.UserID || entry.Billable != first.Billable {
+			http.Error(w, "Only entries of the same project, user and billable status can be merged", http.StatusBadRequest)
+			return
+		}
+		if i > 0 && !entry.StartTime.Equal(*entries[i-1].EndTime) {
+			http.Error(w, "Time entries must be adjacent to be merged", http.StatusBadRequest)
+			return
+		}
+
+		duration += *entry.Duration
+		if entry.Description != "" && !seen[entry.Description] {
+			seen[entry.Description] = true
+			descriptions = append(descriptions, entry.Description)
+		}
+	}
+
+	merged := first
+	merged.EndTime = entries[len(entries)-1].EndTime
+	merged.Duration = &duration
+	merged.Description = strings.Join(descriptions, "; ")
+	if requestBody.Description != nil {
+		merged.Description = *requestBody.Description
+	}
+
+	ids := make([]int, 0, len(entries)-1)
+	for _, entry := range entries[1:] {
+		ids = append(ids, entry.ID)
+	}
+
+	err = tx.QueryRow("UPDATE time_entries SET description = $1, end_time = $2, duration = $3, updated_at = NOW() WHERE id = $4 RETURNING updated_at",
+		merged.Description, merged.EndTime, merged.Duration, merged.ID).Scan(&merged.UpdatedAt)
+	if err == nil {
+		_, err = tx.Exec("INSERT INTO time_entry_tags (time_entry_id, tag_id) SELECT DISTINCT $1::INTEGER, tag_id FROM time_entry_tags WHERE time_entry_id = ANY($2) ON CONFLICT DO NOTHING", merged.ID, pq.Array(ids))
+	}
+	if err == nil {
+		_, err = tx.Exec("DELETE FROM time_entries WHERE id = ANY($1)", pq.Array(ids))
+	}
+	if err == nil {
+		err = recordEntryHistory(tx, r, models.EntryMerge, entries, []models.TimeEntry{merged})
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error merging time entries: %v\n", err)
+		http.Error(w, "Failed to merge time entries", http.StatusInternalServerError)
+		return
+	}
+
+	result := []models.TimeEntry{merged}
+	if err := loadTimeEntryTags(s.db, result); err != nil {
+		fmt.Printf("Error fetching time entry tags: %v\n", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result[0])
+}
+
+// GetTimeEntryHistory lists the splits and merges a time entry took part in,
+// newest first.
+func (s *Server) GetTimeEntryHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	timeEntryID, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, "Time entry ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var projectID int
+	err = s.db.Get(&projectID, "SELECT project_id FROM time_entries WHERE id = $1", timeEntryID)
+	if err == sql.ErrNoRows {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+	if err == nil {
+		_, _, err = authorizeProject(s.db, r, projectID, models.RoleViewer)
+	}
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch time entry history")
+		return
+	}
+
+	match := fmt.Sprintf(`[{"id": %d}]`, timeEntryID)
+	history := []models.TimeEntryHistory{}
+	err = s.db.Select(&history, `SELECT id, action, entries_before, entries_after, user_id, created_at FROM time_entry_history
+		WHERE entries_before @> $1::JSONB OR entries_after @> $1::JSONB
+		ORDER BY created_at DESC, id DESC`, match)
+	if err != nil {
+		fmt.Printf("Error fetching time entry history: %v\n", err)
+		http.Error(w, "Failed to fetch time entry history", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(history)
+}
+
+// checkOperationAccess runs checkEntryLock on a stored entry before a split or
+// merge looks at it. Entries of projects the requesting user cannot see are
+// reported as missing, so the answer does not tell them apart.
+func (s *Server) checkOperationAccess(q sqlx.Queryer, r *http.Request, entry models.TimeEntry) error {
+	err := s.checkEntryLock(q, r, entry.UserID, entry.ProjectID, entry.StartTime)
+	var accessErr *accessError
+	if errors.As(err, &accessErr) && accessErr.status == http.StatusNotFound {
+		return &accessError{http.StatusNotFound, "Time entry not found"}
+	}
+	return err
+}
+
+// recordEntryHistory stores a split or merge done by the requesting user.
+func recordEntryHistory(tx *sqlx.Tx, r *http.Request, action string, before, after []models.TimeEntry) error {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return err
+	}
+
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO time_entry_history (action, entries_before, entries_after, user_id) VALUES ($1, $2, $3, $4)",
+		action, string(beforeJSON), string(afterJSON), userID)
+	return err
+}
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMergeRejectsDuplicateIDs(t *testing.T) {
+	s := &Server{}
+	req := httptest.NewRequest(http.MethodPost, "/api/time-entries/merge", strings.NewReader(`{"ids": [4, 5, 4]}`))
+	rec := httptest.NewRecorder()
+
+	s.MergeTimeEntries(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// etagFor returns a strong entity tag for the JSON representation of v, so
+// any change to a resource, including its tags, gives it a new tag.
+func etagFor(v interface{}) string {
+	data, _ := json.Marshal(v)
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// writeWithETag answers with the JSON representation of v and its entity tag.
+func writeWithETag(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etagFor(v))
+	json.NewEncoder(w).Encode(v)
+}
+
+// checkIfMatch compares the If-Match header of a write with the current
+// representation of the resource. On a mismatch it answers 412 Precondition
+// Failed with the current representation and its entity tag and returns
+// false. Requests without If-Match are let through, unless REQUIRE_IF_MATCH
+// is true, in which case they get 428 Precondition Required.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, current interface{}) bool {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		if os.Getenv("REQUIRE_IF_MATCH") == "true" {
+			http.Error(w, "If-Match header is required", http.StatusPreconditionRequired)
+			return false
+		}
+		return true
+	}
+
+	etag := etagFor(current)
+	if ifMatchAccepts(header, etag) {
+		return true
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusPreconditionFailed)
+	json.NewEncoder(w).Encode(current)
+	return false
+}
+
+// ifMatchAccepts reports whether an If-Match header value lists etag.
+func ifMatchAccepts(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		if tag = strings.TrimSpace(tag); tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"side-sync/pkg/export"
+	"side-sync/pkg/models"
+)
+
+func (s *Server) ExportReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = export.FormatCSV
+	}
+	if !export.IsSupportedFormat(format) {
+		http.Error(w, "Unsupported export format, use csv or xlsx", http.StatusBadRequest)
+		return
+	}
+
+	projectID := r.URL.Query().Get("project_id")
+	dateFrom := r.URL.Query().Get("date_from")
+	dateTo := r.URL.Query().Get("date_to")
+	billableFilter := r.URL.Query().Get("billable")
+	includePricing := r.URL.Query().Get("include_pricing") != "false"
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch projects")
+		return
+	}
+
+	var projects []models.Project
+	if projectID != "" {
+		if _, _, err := authorizeProject(s.db, r, projectID, models.RoleViewer); err != nil {
+			writeAccessError(w, err, "Failed to fetch projects")
+			return
+		}
+		err = s.db.Select(&projects, "SELECT "+projectColumns+" FROM projects WHERE id = $1", projectID)
+	} else {
+		err = s.db.Select(&projects, "SELECT "+projectColumns+" FROM projects WHERE "+memberWorkspacesClause("workspace_id", 1)+" ORDER BY name ASC", userID)
+	}
+	if err != nil {
+		fmt.Printf("Error fetching projects: %v\n", err)
+		http.Error(w, "Failed to fetch projects", http.StatusInternalServerError)
+		return
+	}
+
+	// Amounts use the settings of the exported project's workspace, or of the
+	// current workspace when all projects are exported.
+	var workspaceID int
+	if projectID != "" && len(projects) > 0 {
+		workspaceID = projects[0].WorkspaceID
+	} else if workspaceID, err = currentWorkspace(s.db, r, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch settings")
+		return
+	}
+
+	settings, err := s.requestSettings(r, workspaceID)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+	}
+
+	timeEntries, err := s.fetchReportTimeEntries(projects, dateFrom, dateTo, billableFilter, nil, settings.Location())
+	if err != nil {
+		fmt.Printf("Error fetching time entries: %v\n", err)
+		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
+		return
+	}
+
+	generator := export.NewGenerator()
+	config := export.ReportConfig{
+		Projects:       projects,
+		TimeEntries:    timeEntries,
+		Settings:       settings,
+		IncludePricing: includePricing,
+		DateFrom:       dateFrom,
+		DateTo:         dateTo,
+		BillableFilter: billableFilter,
+	}
+
+	buf, err := generator.Generate(format, config)
+	if err != nil {
+		fmt.Printf("Error generating export: %v\n", err)
+		http.Error(w, "Failed to generate export", http.StatusInternalServerError)
+		return
+	}
+
+	filenameBase := "all-projects"
+	if projectID != "" {
+		filenameBase = projects[0].Name
+	}
+	filename := generator.GetFilename(filenameBase, format)
+
+	w.Header().Set("Content-Type", generator.ContentType(format))
+	w.Header().Set("Content-Disposition", attachment(filename))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+
+	w.Write(buf.Bytes())
+}
+
+// attachment returns a Content-Disposition header offering a download as
+// filename. The name comes from project and client names, so it is quoted
+// and encoded instead of pasted into the header.
+func attachment(filename string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+}
@@ -0,0 +1,254 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+)
+
+const defaultIdempotencyWindow = 24 * time.Hour
+
+// idempotencyLease is how long a request may hold its key without storing a
+// response. Keys held longer belong to requests that died, and a retry takes
+// them over.
+const idempotencyLease = time.Minute
+
+// replayedHeaders are the response headers stored with an idempotent
+// response, besides its Content-Type, and sent again on replay.
+var replayedHeaders = []string{"ETag", "Location"}
+
+// idempotencyWindow returns how long responses to requests with an
+// Idempotency-Key are kept for replay, from IDEMPOTENCY_KEY_TTL.
+func idempotencyWindow() time.Duration {
+	if window, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_KEY_TTL")); err == nil && window > 0 {
+		return window
+	}
+	return defaultIdempotencyWindow
+}
+
+// recordingWriter passes a response through while keeping a copy of its
+// status code and body.
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// idempotent makes a POST handler safe to retry. The first request with a
+// given Idempotency-Key runs the handler and its response is stored; retries
+// with the same key and body within the window get the stored response
+// instead of running the handler again. Only successes and answers that
+// would not change on a retry are stored; other requests can be retried for
+// real.
+func (s *Server) idempotent(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" || r.Method != http.MethodPost {
+			handler(w, r)
+			return
+		}
+		if len(key) > 255 {
+			http.Error(w, "Idempotency-Key must be at most 255 characters", http.StatusBadRequest)
+			return
+		}
+
+		userID, err := currentUserID(r)
+		if err != nil {
+			writeAccessError(w, err, "Failed to process request")
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := hashIdempotentRequest(r, body)
+
+		// Expiry uses the database clock, which also sets created_at, as the
+		// lease is too short to tolerate clock skew.
+		_, err = s.db.Exec("DELETE FROM idempotency_keys WHERE created_at < NOW() - make_interval(secs => $1) OR (status_code IS NULL AND created_at < NOW() - make_interval(secs => $2))",
+			idempotencyWindow().Seconds(), idempotencyLease.Seconds())
+		if err != nil {
+			fmt.Printf("Error expiring idempotency keys: %v\n", err)
+		}
+
+		// created_at tells this request's row apart from one a retry stored
+		// after taking over the key.
+		var createdAt time.Time
+		err = s.db.QueryRow("INSERT INTO idempotency_keys (user_id, key, request_hash) VALUES ($1, $2, $3) ON CONFLICT (user_id, key) DO NOTHING RETURNING created_at",
+			userID, key, requestHash).Scan(&createdAt)
+		if err == sql.ErrNoRows {
+			s.replayIdempotent(w, userID, key, requestHash)
+			return
+		}
+		if err != nil {
+			fmt.Printf("Error storing idempotency key: %v\n", err)
+			http.Error(w, "Failed to process request", http.StatusInternalServerError)
+			return
+		}
+
+		release := func() {
+			if _, err := s.db.Exec("DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND created_at = $3", userID, key, createdAt); err != nil {
+				fmt.Printf("Error releasing idempotency key: %v\n", err)
+			}
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				release()
+				panic(p)
+			}
+		}()
+
+		recorder := &recordingWriter{ResponseWriter: w}
+		handler(recorder, r)
+
+		if !storableStatus(recorder.status) {
+			release()
+			return
+		}
+		headers := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		headersJSON, err := json.Marshal(headers)
+		if err == nil {
+			_, err = s.db.Exec("UPDATE idempotency_keys SET status_code = $1, content_type = $2, response_headers = $3, response_body = $4 WHERE user_id = $5 AND key = $6 AND created_at = $7",
+				recorder.status, recorder.Header().Get("Content-Type"), string(headersJSON), recorder.body.Bytes(), userID, key, createdAt)
+		}
+		if err != nil {
+			fmt.Printf("Error storing idempotent response: %v\n", err)
+		}
+	}
+}
+
+// storableStatus reports whether a response with the status is stored for
+// replay: successes, and validation errors that a retry of the same request
+// would get again. Conflicts, locks and failed preconditions can clear up, so
+// retries of those run the handler again.
+func storableStatus(status int) bool {
+	switch {
+	case status >= 200 && status < 300:
+		return true
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return true
+	}
+	return false
+}
+
+// hashIdempotentRequest returns the hash that tells retries of a request
+// apart from other requests with the same key. Multipart forms are hashed by
+// their fields and files, since every retry may use a new boundary.
+func hashIdempotentRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+
+	if parts, ok := multipartParts(r, body); ok {
+		for _, part := range parts {
+			for _, field := range part {
+				binary.Write(hash, binary.BigEndian, uint64(len(field)))
+				hash.Write(field)
+			}
+		}
+	} else {
+		hash.Write(body)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// multipartParts returns the form name, file name and content of every part
+// of a multipart/form-data body, sorted by form name. ok is false for other
+// bodies and for forms that cannot be read.
+func multipartParts(r *http.Request, body []byte) (parts [][3][]byte, ok bool) {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+		return nil, false
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, false
+		}
+		parts = append(parts, [3][]byte{[]byte(part.FormName()), []byte(part.FileName()), content})
+	}
+
+	sort.SliceStable(parts, func(i, j int) bool {
+		return bytes.Compare(parts[i][0], parts[j][0]) < 0
+	})
+	return parts, true
+}
+
+// replayIdempotent answers a retried request with the stored response of the
+// first request with the same key.
+func (s *Server) replayIdempotent(w http.ResponseWriter, userID int, key, requestHash string) {
+	var stored struct {
+		RequestHash     string         `db:"request_hash"`
+		StatusCode      sql.NullInt64  `db:"status_code"`
+		ContentType     sql.NullString `db:"content_type"`
+		ResponseHeaders sql.NullString `db:"response_headers"`
+		ResponseBody    []byte         `db:"response_body"`
+	}
+	err := s.db.Get(&stored, "SELECT request_hash, status_code, content_type, response_headers, response_body FROM idempotency_keys WHERE user_id = $1 AND key = $2", userID, key)
+	if err != nil {
+		fmt.Printf("Error fetching idempotency key: %v\n", err)
+		http.Error(w, "Failed to process request", http.StatusInternalServerError)
+		return
+	}
+
+	switch {
+	case stored.RequestHash != requestHash:
+		http.Error(w, "Idempotency-Key was already used for a different request", http.StatusUnprocessableEntity)
+	case !stored.StatusCode.Valid:
+		http.Error(w, "A request with this Idempotency-Key is still being processed", http.StatusConflict)
+	default:
+		if stored.ContentType.String != "" {
+			w.Header().Set("Content-Type", stored.ContentType.String)
+		}
+		var headers map[string]string
+		if stored.ResponseHeaders.Valid && json.Unmarshal([]byte(stored.ResponseHeaders.String), &headers) == nil {
+			for name, value := range headers {
+				w.Header().Set(name, value)
+			}
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(int(stored.StatusCode.Int64))
+		w.Write(stored.ResponseBody)
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestStorableStatus(t *testing.T) {
+	want := map[int]bool{
+		http.StatusOK:                  true,
+		http.StatusCreated:             true,
+		http.StatusBadRequest:          true,
+		http.StatusUnprocessableEntity: true,
+		http.StatusForbidden:           false,
+		http.StatusConflict:            false,
+		http.StatusPreconditionFailed:  false,
+		http.StatusLocked:              false,
+		http.StatusInternalServerError: false,
+		http.StatusServiceUnavailable:  false,
+	}
+	for status, stored := range want {
+		if got := storableStatus(status); got != stored {
+			t.Errorf("status %d: stored %v, want %v", status, got, stored)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPISpec describes every route and model of the API. Update it together
+// with the handlers; pkg/apiclient is generated from it.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// GetOpenAPISpec serves the OpenAPI 3 document of the API.
+func (s *Server) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPISpec)
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// routeSource parses routes.go.
+func routeSource(t *testing.T) *ast.File {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "routes.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// funcBody returns the body of the named function in file.
+func funcBody(t *testing.T, file *ast.File, name string) *ast.BlockStmt {
+	t.Helper()
+
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == name {
+			return fn.Body
+		}
+	}
+	t.Fatalf("%s not found in routes.go", name)
+	return nil
+}
+
+// stringArg returns the value of a string literal argument.
+func stringArg(t *testing.T, expr ast.Expr) (string, bool) {
+	t.Helper()
+
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		t.Errorf("route pattern %v is not a string literal", expr)
+		return "", false
+	}
+	value, _ := strconv.Unquote(lit.Value)
+	return value, true
+}
+
+// handlerName returns the name of the Server method a route calls, looking
+// through wrappers such as withPathValues and s.idempotent.
+func handlerName(expr ast.Expr) string {
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			break
+		}
+		expr = call.Args[0]
+	}
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
+// mountedRoutes returns the "METHOD /path" patterns a function registers with
+// mux.Handle or mux.HandleFunc, and the handler of each. Patterns without a
+// method are taken as GET.
+func mountedRoutes(t *testing.T, body *ast.BlockStmt) map[string]string {
+	t.Helper()
+
+	routes := make(map[string]string)
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Handle" && sel.Sel.Name != "HandleFunc") {
+			return true
+		}
+		if _, isVar := call.Args[0].(*ast.Ident); isVar {
+			// The legacy helper's own mux.Handle call.
+			return true
+		}
+		pattern, ok := stringArg(t, call.Args[0])
+		if !ok {
+			return true
+		}
+		method, path, found := strings.Cut(pattern, " ")
+		if !found {
+			method, path = "GET", pattern
+		}
+		routes[method+" "+path] = handlerName(call.Args[1])
+		return true
+	})
+	return routes
+}
+
+// v1Routes returns the "METHOD /path" patterns setupV1Routes registers, and
+// the handler of each.
+func v1Routes(t *testing.T) map[string]string {
+	t.Helper()
+
+	routes := mountedRoutes(t, funcBody(t, routeSource(t), "setupV1Routes"))
+	if len(routes) == 0 {
+		t.Fatal("no routes found in setupV1Routes")
+	}
+	for route := range routes {
+		if _, path, _ := strings.Cut(route, " "); !strings.HasPrefix(path, "/api/v1/") {
+			t.Errorf("route %q is outside /api/v1", route)
+		}
+	}
+	return routes
+}
+
+// legacyRoutes returns the "METHOD /path" patterns of the deprecated routes
+// SetupRoutes registers. The methods of a route that dispatches on the method
+// are its switch cases; the methods of one that calls a handler directly are
+// those the handler serves under /api/v1.
+func legacyRoutes(t *testing.T) map[string]bool {
+	t.Helper()
+
+	v1Methods := make(map[string][]string)
+	for route, handler := range v1Routes(t) {
+		method, _, _ := strings.Cut(route, " ")
+		v1Methods[handler] = append(v1Methods[handler], method)
+	}
+
+	routes := make(map[string]bool)
+	ast.Inspect(funcBody(t, routeSource(t), "SetupRoutes"), func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		if fn, ok := call.Fun.(*ast.Ident); !ok || fn.Name != "legacy" {
+			return true
+		}
+		path, ok := stringArg(t, call.Args[0])
+		if !ok {
+			return true
+		}
+
+		var methods []string
+		if lit, ok := call.Args[1].(*ast.FuncLit); ok {
+			ast.Inspect(lit.Body, func(node ast.Node) bool {
+				clause, ok := node.(*ast.CaseClause)
+				if !ok {
+					return true
+				}
+				for _, expr := range clause.List {
+					if sel, ok := expr.(*ast.SelectorExpr); ok && strings.HasPrefix(sel.Sel.Name, "Method") {
+						methods = append(methods, strings.ToUpper(strings.TrimPrefix(sel.Sel.Name, "Method")))
+					}
+				}
+				return true
+			})
+		} else {
+			methods = v1Methods[handlerName(call.Args[1])]
+		}
+		if len(methods) == 0 {
+			t.Errorf("cannot tell the methods of legacy route %s", path)
+		}
+		for _, method := range methods {
+			routes[method+" "+path] = true
+		}
+		return true
+	})
+	if len(routes) == 0 {
+		t.Fatal("no legacy routes found in SetupRoutes")
+	}
+	return routes
+}
+
+// specOperations returns the operations of the OpenAPI document by their
+// "METHOD /path".
+func specOperations(t *testing.T) map[string]json.RawMessage {
+	t.Helper()
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	methods := map[string]bool{"get": true, "put": true, "post": true, "patch": true, "delete": true, "head": true, "options": true}
+	operations := make(map[string]json.RawMessage)
+	for path, item := range spec.Paths {
+		for key, operation := range item {
+			if methods[key] {
+				operations[strings.ToUpper(key)+" "+path] = operation
+			}
+		}
+	}
+	return operations
+}
+
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	legacy := legacyRoutes(t)
+	registered := make(map[string]bool)
+	for route := range mountedRoutes(t, funcBody(t, routeSource(t), "SetupRoutes")) {
+		registered[route] = true
+	}
+	for route := range v1Routes(t) {
+		registered[route] = true
+	}
+	for route := range legacy {
+		registered[route] = true
+	}
+	documented := specOperations(t)
+
+	var missing, extra []string
+	for route := range registered {
+		if _, ok := documented[route]; !ok {
+			missing = append(missing, route)
+		}
+	}
+	for route, operation := range documented {
+		if !registered[route] {
+			extra = append(extra, route)
+			continue
+		}
+		var op struct {
+			Deprecated bool `json:"deprecated"`
+		}
+		if err := json.Unmarshal(operation, &op); err != nil {
+			t.Fatal(err)
+		}
+		if op.Deprecated != legacy[route] {
+			t.Errorf("openapi.json marks %s deprecated: %v, want %v", route, op.Deprecated, legacy[route])
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+
+	for _, route := range missing {
+		t.Errorf("route %s is not in openapi.json", route)
+	}
+	for _, route := range extra {
+		t.Errorf("openapi.json documents %s, which is not registered", route)
+	}
+}
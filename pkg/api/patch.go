@@ -0,0 +1,80 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+)
+
+// decodeMergePatch applies the JSON merge patch (RFC 7386) in the request
+// body to value, a pointer to the current state of a resource. Fields left
+// out of the patch keep their value and fields set to null are cleared.
+func decodeMergePatch(r *http.Request, value interface{}) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("Failed to read request body")
+	}
+
+	var patch interface{}
+	if err := decodeJSONNumbers(body, &patch); err != nil {
+		return fmt.Errorf("Invalid JSON payload")
+	}
+	if _, ok := patch.(map[string]interface{}); !ok {
+		return fmt.Errorf("Patch must be a JSON object")
+	}
+
+	current, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var document interface{}
+	if err := decodeJSONNumbers(current, &document); err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergePatch(document, patch))
+	if err != nil {
+		return err
+	}
+
+	// Decode into the zero value so that removed fields end up cleared.
+	target := reflect.ValueOf(value).Elem()
+	target.Set(reflect.Zero(target.Type()))
+	if err := json.Unmarshal(merged, value); err != nil {
+		return fmt.Errorf("Invalid JSON payload")
+	}
+	return nil
+}
+
+// mergePatch returns target with patch merged into it. Objects are merged
+// key by key, null removes a key and any other value replaces the target.
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// decodeJSONNumbers decodes data keeping numbers as json.Number, so large
+// integers and exact decimals survive a round trip.
+func decodeJSONNumbers(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -1,16 +1,28 @@
 package api
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
 )
 
+const projectColumns = "id, name, description, user_id, workspace_id, client_id, hourly_rate, rounding_mode, rounding_minutes, rounding_scope, TO_CHAR(locked_until, 'YYYY-MM-DD') AS locked_until, created_at, updated_at"
+
 func (s *Server) GetProjects(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch projects")
+		return
+	}
+
 	var projects []models.Project
-	err := s.db.Select(&projects, "SELECT id, name, description, user_id, hourly_rate, created_at, updated_at FROM projects ORDER BY created_at DESC")
+	err = s.db.Select(&projects, "SELECT "+projectColumns+" FROM projects WHERE "+memberWorkspacesClause("workspace_id", 1)+" ORDER BY created_at DESC", userID)
 	if err != nil {
 		http.Error(w, "Failed to fetch projects", http.StatusInternalServerError)
 		return
@@ -32,8 +44,55 @@ func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	query := `INSERT INTO projects (name, description, user_id, hourly_rate) VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at`
-	err := s.db.QueryRow(query, project.Name, project.Description, project.UserID, project.HourlyRate).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+	if err := validateRounding(project.RoundingMode, project.RoundingMinutes, &project.RoundingScope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateLockDate(project.LockedUntil); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	userID, err := s.authorizeNewProject(r, &project)
+	if err != nil {
+		writeAccessError(w, err, "Failed to create project")
+		return
+	}
+	if project.UserID == 0 {
+		project.UserID = userID
+	}
+
+	// The project's user becomes a project member, so it must belong to the workspace.
+	if project.UserID != userID {
+		role, err := workspaceRole(s.db, project.UserID, project.WorkspaceID)
+		if err != nil {
+			fmt.Printf("Error fetching workspace role: %v\n", err)
+			http.Error(w, "Failed to create project", http.StatusInternalServerError)
+			return
+		}
+		if role == "" {
+			http.Error(w, "User is not a member of the workspace", http.StatusBadRequest)
+			return
+		}
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to create project", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO projects (name, description, user_id, workspace_id, client_id, hourly_rate, rounding_mode, rounding_minutes, rounding_scope, locked_until) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, created_at, updated_at`
+	err = tx.QueryRow(query, project.Name, project.Description, project.UserID, project.WorkspaceID, project.ClientID, project.HourlyRate, project.RoundingMode, project.RoundingMinutes, project.RoundingScope, project.LockedUntil).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+	if err == nil {
+		_, err = tx.Exec(`INSERT INTO project_members (project_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`, project.ID, project.UserID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error creating project: %v\n", err)
 		http.Error(w, "Failed to create project", http.StatusInternalServerError)
@@ -57,8 +116,13 @@ func (s *Server) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch project")
+		return
+	}
+
 	var project models.Project
-	query := "SELECT id, name, description, user_id, hourly_rate, created_at, updated_at FROM projects WHERE id = $1"
+	query := "SELECT " + projectColumns + " FROM projects WHERE id = $1"
 	err := s.db.Get(&project, query, projectID)
 	if err != nil {
 		fmt.Printf("Error fetching project: %v\n", err)
@@ -66,12 +130,13 @@ func (s *Server) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(project)
+	writeWithETag(w, project)
 }
 
+// UpdateProject replaces a project with PUT, or changes the fields in a JSON
+// merge patch with PATCH.
 func (s *Server) UpdateProject(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "PUT" {
+	if r.Method != "PUT" && r.Method != http.MethodPatch {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
@@ -82,26 +147,79 @@ func (s *Server) UpdateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to update project")
+		return
+	}
+
+	// The project stays locked until the update commits, so it cannot change
+	// between checking If-Match and writing it.
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update project", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var current models.Project
+	if err := tx.Get(&current, "SELECT "+projectColumns+" FROM projects WHERE id = $1 FOR UPDATE", projectID); err != nil {
+		fmt.Printf("Error fetching project: %v\n", err)
+		http.Error(w, "Failed to update project", http.StatusInternalServerError)
+		return
+	}
+
 	var project models.Project
-	if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
+	if r.Method == http.MethodPatch {
+		project = current
+		if err := decodeMergePatch(r, &project); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
 		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
 		return
 	}
 
-	query := `UPDATE projects SET name = $1, description = $2, hourly_rate = $3, updated_at = NOW() WHERE id = $4 RETURNING id, created_at, updated_at`
-	err := s.db.QueryRow(query, project.Name, project.Description, project.HourlyRate, projectID).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+	if strings.TrimSpace(project.Name) == "" {
+		http.Error(w, "Project name is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := validateRounding(project.RoundingMode, project.RoundingMinutes, &project.RoundingScope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateLockDate(project.LockedUntil); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := checkProjectClient(tx, project.ClientID, current.WorkspaceID); err != nil {
+		writeAccessError(w, err, "Failed to update project")
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
+	query := `UPDATE projects SET name = $1, description = $2, client_id = $3, hourly_rate = $4, rounding_mode = $5, rounding_minutes = $6, rounding_scope = $7, locked_until = $8, updated_at = NOW() WHERE id = $9 RETURNING id, created_at, updated_at`
+	err = tx.QueryRow(query, project.Name, project.Description, project.ClientID, project.HourlyRate, project.RoundingMode, project.RoundingMinutes, project.RoundingScope, project.LockedUntil, projectID).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+	if err == nil {
+		err = tx.Get(&project, "SELECT "+projectColumns+" FROM projects WHERE id = $1", projectID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error updating project: %v\n", err)
 		http.Error(w, "Failed to update project", http.StatusInternalServerError)
 		return
 	}
 
-	var userID int
-	s.db.Get(&userID, "SELECT user_id FROM projects WHERE id = $1", projectID)
-	project.UserID = userID
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(project)
+	writeWithETag(w, project)
 }
 
 func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request) {
@@ -116,8 +234,34 @@ func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to delete project")
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to delete project", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var current models.Project
+	if err := tx.Get(&current, "SELECT "+projectColumns+" FROM projects WHERE id = $1 FOR UPDATE", projectID); err != nil {
+		fmt.Printf("Error fetching project: %v\n", err)
+		http.Error(w, "Failed to delete project", http.StatusInternalServerError)
+		return
+	}
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
 	query := `DELETE FROM projects WHERE id = $1`
-	_, err := s.db.Exec(query, projectID)
+	_, err = tx.Exec(query, projectID)
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error deleting project: %v\n", err)
 		http.Error(w, "Failed to delete project", http.StatusInternalServerError)
@@ -131,3 +275,40 @@ func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// authorizeNewProject checks that the requesting user is an admin of the
+// workspace the project is created in, defaulting it to the current
+// workspace, and that the project's client belongs to the same workspace.
+func (s *Server) authorizeNewProject(r *http.Request, project *models.Project) (int, error) {
+	var err error
+	if project.WorkspaceID == 0 {
+		project.WorkspaceID, err = currentWorkspace(s.db, r, models.RoleAdmin)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	userID, err := authorizeWorkspace(s.db, r, project.WorkspaceID, models.RoleAdmin)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := checkProjectClient(s.db, project.ClientID, project.WorkspaceID); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// checkProjectClient checks that a project's client belongs to the
+// project's workspace.
+func checkProjectClient(q sqlx.Queryer, clientID *int, workspaceID int) error {
+	if clientID == nil {
+		return nil
+	}
+
+	var clientWorkspaceID int
+	err := sqlx.Get(q, &clientWorkspaceID, "SELECT workspace_id FROM clients WHERE id = $1", *clientID)
+	if err == sql.ErrNoRows || (err == nil && clientWorkspaceID != workspaceID) {
+		return &accessError{http.StatusBadRequest, "Client not found in this workspace"}
+	}
+	return err
+}
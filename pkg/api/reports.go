@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"side-sync/pkg/models"
 	"side-sync/pkg/pdf"
+
+	"github.com/lib/pq"
 )
 
 func (s *Server) GeneratePDFReport(w http.ResponseWriter, r *http.Request) {
@@ -17,7 +21,11 @@ func (s *Server) GeneratePDFReport(w http.ResponseWriter, r *http.Request) {
 
 	projectID := r.URL.Query().Get("project_id")
 	if projectID == "" {
-		http.Error(w, "Project ID is required", http.StatusBadRequest)
+		if r.URL.Query().Get("project_ids") != "" || r.URL.Query().Get("client_id") != "" {
+			s.generateMultiProjectPDFReport(w, r)
+			return
+		}
+		http.Error(w, "Project ID, project IDs or client ID is required", http.StatusBadRequest)
 		return
 	}
 
@@ -25,78 +33,338 @@ func (s *Server) GeneratePDFReport(w http.ResponseWriter, r *http.Request) {
 	dateTo := r.URL.Query().Get("date_to")
 	billableFilter := r.URL.Query().Get("billable")
 	includePricing := r.URL.Query().Get("include_pricing") != "false"
+	groupBy := r.URL.Query().Get("group_by")
+	collapse := r.URL.Query().Get("collapse") == "true"
+
+	if !pdf.IsSupportedGroupBy(groupBy) {
+		http.Error(w, "Invalid group_by value, use day, week, task or tag", http.StatusBadRequest)
+		return
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if locale != "" && !pdf.IsSupportedLocale(locale) {
+		http.Error(w, "Unsupported locale", http.StatusBadRequest)
+		return
+	}
+
+	columns, rounding, err := reportLayout(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to generate PDF")
+		return
+	}
 
 	var project models.Project
-	err := s.db.Get(&project, "SELECT id, name, description, user_id, hourly_rate, created_at, updated_at FROM projects WHERE id = $1", projectID)
+	err = s.db.Get(&project, "SELECT "+projectColumns+" FROM projects WHERE id = $1", projectID)
 	if err != nil {
 		http.Error(w, "Project not found", http.StatusNotFound)
 		return
 	}
 
-	var settings models.Settings
-	err = s.db.Get(&settings, "SELECT id, default_hourly_rate, currency, created_at, updated_at FROM settings LIMIT 1")
+	settings, err := s.requestSettings(r, project.WorkspaceID)
 	if err != nil {
 		fmt.Printf("Error fetching settings: %v\n", err)
 	}
 
-	query := "SELECT id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at FROM time_entries WHERE project_id = $1"
-	args := []interface{}{projectID}
-	argIndex := 2
+	timeEntries, err := s.fetchReportTimeEntries([]models.Project{project}, dateFrom, dateTo, billableFilter, rounding, settings.Location())
+	if err != nil {
+		fmt.Printf("Error fetching time entries: %v\n", err)
+		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
+		return
+	}
 
-	if dateFrom != "" {
-		query += fmt.Sprintf(" AND DATE(start_time) >= $%d", argIndex)
-		args = append(args, dateFrom)
-		argIndex++
+	branding, err := s.resolveBranding(project.WorkspaceID, project.ClientID)
+	if err != nil {
+		fmt.Printf("Error fetching branding: %v\n", err)
 	}
 
-	if dateTo != "" {
-		query += fmt.Sprintf(" AND DATE(start_time) <= $%d", argIndex)
-		args = append(args, dateTo)
-		argIndex++
+	if locale == "" {
+		locale = s.clientLocale(project.ClientID)
 	}
 
-	if billableFilter == "billable" {
-		query += fmt.Sprintf(" AND billable = $%d", argIndex)
-		args = append(args, true)
-	} else if billableFilter == "non-billable" {
-		query += fmt.Sprintf(" AND billable = $%d", argIndex)
-		args = append(args, false)
+	generator := pdf.NewGenerator()
+	config := pdf.ReportConfig{
+		Project:        project,
+		TimeEntries:    timeEntries,
+		Settings:       settings,
+		IncludePricing: includePricing,
+		DateFrom:       dateFrom,
+		DateTo:         dateTo,
+		BillableFilter: billableFilter,
+		Branding:       branding,
+		GroupBy:        groupBy,
+		Collapse:       collapse,
+		Locale:         locale,
+		Columns:        columns,
 	}
 
-	query += " ORDER BY start_time ASC"
+	buf, err := generator.GenerateTimeReport(config)
+	if err != nil {
+		fmt.Printf("Error generating PDF: %v\n", err)
+		http.Error(w, "Failed to generate PDF", http.StatusInternalServerError)
+		return
+	}
 
-	var timeEntries []models.TimeEntry
-	err = s.db.Select(&timeEntries, query, args...)
+	filename := generator.GetFilename(project.Name)
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", attachment(filename))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+
+	w.Write(buf.Bytes())
+}
+
+func (s *Server) generateMultiProjectPDFReport(w http.ResponseWriter, r *http.Request) {
+	dateFrom := r.URL.Query().Get("date_from")
+	dateTo := r.URL.Query().Get("date_to")
+	billableFilter := r.URL.Query().Get("billable")
+	includePricing := r.URL.Query().Get("include_pricing") != "false"
+	groupBy := r.URL.Query().Get("group_by")
+	collapse := r.URL.Query().Get("collapse") == "true"
+
+	if !pdf.IsSupportedGroupBy(groupBy) {
+		http.Error(w, "Invalid group_by value, use day, week, task or tag", http.StatusBadRequest)
+		return
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if locale != "" && !pdf.IsSupportedLocale(locale) {
+		http.Error(w, "Unsupported locale", http.StatusBadRequest)
+		return
+	}
+
+	columns, rounding, err := reportLayout(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var client *models.Client
+	var projects []models.Project
+
+	if clientID := r.URL.Query().Get("client_id"); clientID != "" {
+		if err := authorizeClient(s.db, r, clientID, models.RoleViewer); err != nil {
+			writeAccessError(w, err, "Failed to generate PDF")
+			return
+		}
+		client = &models.Client{}
+		err = s.db.Get(client, "SELECT "+clientColumns+" FROM clients WHERE id = $1", clientID)
+		if err != nil {
+			http.Error(w, "Client not found", http.StatusNotFound)
+			return
+		}
+		err = s.db.Select(&projects, "SELECT "+projectColumns+" FROM projects WHERE client_id = $1 ORDER BY name ASC", client.ID)
+	} else {
+		var projectIDs []int
+		for _, value := range strings.Split(r.URL.Query().Get("project_ids"), ",") {
+			id, convErr := strconv.Atoi(strings.TrimSpace(value))
+			if convErr != nil {
+				http.Error(w, "Invalid project IDs", http.StatusBadRequest)
+				return
+			}
+			if _, _, err := authorizeProject(s.db, r, id, models.RoleViewer); err != nil {
+				writeAccessError(w, err, "Failed to generate PDF")
+				return
+			}
+			projectIDs = append(projectIDs, id)
+		}
+		err = s.db.Select(&projects, "SELECT "+projectColumns+" FROM projects WHERE id = ANY($1) ORDER BY name ASC", pq.Array(projectIDs))
+	}
+	if err != nil {
+		fmt.Printf("Error fetching projects: %v\n", err)
+		http.Error(w, "Failed to fetch projects", http.StatusInternalServerError)
+		return
+	}
+
+	if len(projects) == 0 {
+		http.Error(w, "No projects found", http.StatusNotFound)
+		return
+	}
+
+	// Rates and currency come from the workspace settings, so one report
+	// cannot mix workspaces.
+	for _, project := range projects[1:] {
+		if project.WorkspaceID != projects[0].WorkspaceID {
+			http.Error(w, "Projects must belong to the same workspace", http.StatusBadRequest)
+			return
+		}
+	}
+
+	settings, err := s.requestSettings(r, projects[0].WorkspaceID)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+	}
+
+	timeEntries, err := s.fetchReportTimeEntries(projects, dateFrom, dateTo, billableFilter, rounding, settings.Location())
 	if err != nil {
 		fmt.Printf("Error fetching time entries: %v\n", err)
 		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
 		return
 	}
 
+	var clientID *int
+	if client != nil {
+		clientID = &client.ID
+	}
+	branding, err := s.resolveBranding(projects[0].WorkspaceID, clientID)
+	if err != nil {
+		fmt.Printf("Error fetching branding: %v\n", err)
+	}
+
+	if locale == "" && client != nil {
+		locale = client.Locale
+	}
+
 	generator := pdf.NewGenerator()
-	config := pdf.ReportConfig{
-		Project:        project,
+	config := pdf.MultiProjectReportConfig{
+		Projects:       projects,
+		Client:         client,
 		TimeEntries:    timeEntries,
 		Settings:       settings,
 		IncludePricing: includePricing,
 		DateFrom:       dateFrom,
 		DateTo:         dateTo,
 		BillableFilter: billableFilter,
+		Branding:       branding,
+		GroupBy:        groupBy,
+		Collapse:       collapse,
+		Locale:         locale,
+		Columns:        columns,
 	}
 
-	buf, err := generator.GenerateTimeReport(config)
+	buf, err := generator.GenerateMultiProjectReport(config)
 	if err != nil {
 		fmt.Printf("Error generating PDF: %v\n", err)
 		http.Error(w, "Failed to generate PDF", http.StatusInternalServerError)
 		return
 	}
 
-	filename := generator.GetFilename(project.Name)
+	filenameBase := "multi-project"
+	if client != nil {
+		filenameBase = client.Name
+	}
+	filename := generator.GetFilename(filenameBase)
 
 	w.Header().Set("Content-Type", "application/pdf")
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	w.Header().Set("Content-Disposition", attachment(filename))
 	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
 
 	w.Write(buf.Bytes())
 }
 
+// reportLayout reads the optional column selection of a PDF report and the
+// rounding rule that replaces the configured rounding policies for it.
+func reportLayout(r *http.Request) ([]string, *models.Rounding, error) {
+	var columns []string
+	if value := r.URL.Query().Get("columns"); value != "" {
+		for _, column := range strings.Split(value, ",") {
+			column = strings.TrimSpace(column)
+			if !pdf.IsSupportedColumn(column) {
+				return nil, nil, fmt.Errorf("Unsupported column %q", column)
+			}
+			columns = append(columns, column)
+		}
+	}
+
+	value := r.URL.Query().Get("round_minutes")
+	if value == "" {
+		return columns, nil, nil
+	}
+
+	minutes, err := strconv.Atoi(value)
+	if err != nil || minutes < 0 {
+		return nil, nil, fmt.Errorf("Invalid round_minutes value")
+	}
+
+	rounding := &models.Rounding{Mode: models.RoundUp, Minutes: minutes, Scope: models.RoundPerEntry}
+	if mode := r.URL.Query().Get("round_mode"); mode != "" {
+		if !models.IsSupportedRoundingMode(mode) {
+			return nil, nil, fmt.Errorf("Invalid round_mode value, use none, up, nearest or down")
+		}
+		rounding.Mode = mode
+	}
+	if scope := r.URL.Query().Get("round_scope"); scope != "" {
+		if !models.IsSupportedRoundingScope(scope) {
+			return nil, nil, fmt.Errorf("Invalid round_scope value, use entry or day")
+		}
+		rounding.Scope = scope
+	}
+
+	return columns, rounding, nil
+}
+
+func (s *Server) clientLocale(clientID *int) string {
+	if clientID == nil {
+		return ""
+	}
+
+	var locale string
+	if err := s.db.Get(&locale, "SELECT locale FROM clients WHERE id = $1", *clientID); err != nil {
+		fmt.Printf("Error fetching client locale: %v\n", err)
+	}
+	return locale
+}
+
+// fetchReportTimeEntries returns the time entries of the projects, rounded
+// with roundReportEntries. Dates are compared in the given timezone, and
+// entries that cross midnight into or out of the date range only count with
+// their part inside it. Whole entries are rounded before they are clipped, so
+// the parts share the rounded duration instead of being rounded one by one.
+func (s *Server) fetchReportTimeEntries(projects []models.Project, dateFrom, dateTo, billableFilter string, rounding *models.Rounding, loc *time.Location) ([]models.TimeEntry, error) {
+	projectIDs := make([]int, 0, len(projects))
+	for _, project := range projects {
+		projectIDs = append(projectIDs, project.ID)
+	}
+
+	query := "SELECT id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at FROM time_entries WHERE 1 = 1"
+	args := []interface{}{}
+	argIndex := 1
+
+	if len(projectIDs) > 0 {
+		query += fmt.Sprintf(" AND project_id = ANY($%d)", argIndex)
+		args = append(args, pq.Array(projectIDs))
+		argIndex++
+	}
+
+	if dateFrom != "" {
+		query += fmt.Sprintf(" AND (DATE(start_time AT TIME ZONE $%d) >= $%d OR end_time > $%d::DATE::TIMESTAMP AT TIME ZONE $%d)", argIndex, argIndex+1, argIndex+1, argIndex)
+		args = append(args, loc.String(), dateFrom)
+		argIndex += 2
+	}
+
+	if dateTo != "" {
+		query += fmt.Sprintf(" AND DATE(start_time AT TIME ZONE $%d) <= $%d", argIndex, argIndex+1)
+		args = append(args, loc.String(), dateTo)
+		argIndex += 2
+	}
+
+	if billableFilter == "billable" {
+		query += fmt.Sprintf(" AND billable = $%d", argIndex)
+		args = append(args, true)
+	} else if billableFilter == "non-billable" {
+		query += fmt.Sprintf(" AND billable = $%d", argIndex)
+		args = append(args, false)
+	}
+
+	query += " ORDER BY start_time ASC"
+
+	var timeEntries []models.TimeEntry
+	if err := s.db.Select(&timeEntries, query, args...); err != nil {
+		return nil, err
+	}
+
+	if err := loadTimeEntryTags(s.db, timeEntries); err != nil {
+		return nil, err
+	}
+
+	timeEntries, err := s.roundReportEntries(timeEntries, projects, rounding)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ClipEntriesToDates(timeEntries, dateFrom, dateTo, loc), nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/lib/pq"
+)
+
+// validateRounding checks a rounding policy from a request body and fills in
+// the default scope. A nil mode means the policy is inherited.
+func validateRounding(mode *string, minutes int, scope *string) error {
+	if *scope == "" {
+		*scope = models.RoundPerEntry
+	}
+	if mode == nil {
+		return nil
+	}
+
+	if !models.IsSupportedRoundingMode(*mode) {
+		return fmt.Errorf("Invalid rounding_mode value, use none, up, nearest or down")
+	}
+	if !models.IsSupportedRoundingScope(*scope) {
+		return fmt.Errorf("Invalid rounding_scope value, use entry or day")
+	}
+	if minutes < 0 || (*mode != models.RoundNone && minutes == 0) {
+		return fmt.Errorf("rounding_minutes must be a positive number of minutes")
+	}
+	return nil
+}
+
+// roundReportEntries returns the entries with their durations rounded under
+// the rounding policy of their project, or under override when it is set.
+// Projects without a policy of their own or of their client use the default
+// of their workspace, whose timezone decides the days of the day scope. The
+// raw durations in time_entries are left untouched.
+func (s *Server) roundReportEntries(entries []models.TimeEntry, projects []models.Project, override *models.Rounding) ([]models.TimeEntry, error) {
+	var clientIDs []int
+	workspaces := make(map[int]models.Settings)
+	for _, project := range projects {
+		if project.ClientID != nil {
+			clientIDs = append(clientIDs, *project.ClientID)
+		}
+		if _, ok := workspaces[project.WorkspaceID]; !ok {
+			settings, err := loadWorkspaceSettings(s.db, project.WorkspaceID)
+			if err != nil {
+				return nil, err
+			}
+			workspaces[project.WorkspaceID] = settings
+		}
+	}
+
+	clients := make(map[int]*models.Client)
+	if len(clientIDs) > 0 && override == nil {
+		var rows []models.Client
+		if err := s.db.Select(&rows, "SELECT "+clientColumns+" FROM clients WHERE id = ANY($1)", pq.Array(clientIDs)); err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			clients[rows[i].ID] = &rows[i]
+		}
+	}
+
+	policies := make(map[int]models.Rounding, len(projects))
+	locations := make(map[int]*time.Location, len(projects))
+	for _, project := range projects {
+		locations[project.ID] = time.UTC
+		if settings, ok := workspaces[project.WorkspaceID]; ok {
+			locations[project.ID] = settings.Location()
+		}
+		if override != nil {
+			policies[project.ID] = *override
+			continue
+		}
+		var client *models.Client
+		if project.ClientID != nil {
+			client = clients[*project.ClientID]
+		}
+		policies[project.ID] = models.ResolveRounding(workspaces[project.WorkspaceID], client, project)
+	}
+
+	byProject := make(map[int][]int)
+	for i, entry := range entries {
+		byProject[entry.ProjectID] = append(byProject[entry.ProjectID], i)
+	}
+
+	rounded := make([]models.TimeEntry, len(entries))
+	for projectID, indexes := range byProject {
+		group := make([]models.TimeEntry, len(indexes))
+		for j, i := range indexes {
+			group[j] = entries[i]
+		}
+		for j, entry := range policies[projectID].RoundEntries(group, locations[projectID]) {
+			rounded[indexes[j]] = entry
+		}
+	}
+
+	return rounded, nil
+}
@@ -8,35 +8,57 @@ func (s *Server) SetupRoutes() *http.ServeMux {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/healthz", s.HealthCheck)
-	mux.HandleFunc("/api/users", s.GetUsers)
-	mux.HandleFunc("/api/projects", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("GET /api/openapi.json", s.GetOpenAPISpec)
+	s.setupV1Routes(mux)
+
+	// The routes below predate /api/v1 and are kept as deprecated aliases.
+	legacy := func(pattern string, handler http.HandlerFunc) {
+		mux.Handle(pattern, deprecated(handler))
+	}
+	legacy("/api/users", s.GetUsers)
+	legacy("/api/projects", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			s.GetProjects(w, r)
 		case http.MethodPost:
-			s.CreateProject(w, r)
+			s.idempotent(s.CreateProject)(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/time-entries", func(w http.ResponseWriter, r *http.Request) {
+	legacy("/api/time-entries", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			s.GetTimeEntries(w, r)
 		case http.MethodPost:
-			s.CreateTimeEntry(w, r)
+			s.idempotent(s.CreateTimeEntry)(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/time-entries/project", s.GetTimeEntriesByProject)
+	legacy("/api/time-entries/billable", s.UpdateTimeEntryBillable)
+	legacy("/api/time-entries/tags", s.UpdateTimeEntryTags)
+	legacy("/api/time-entries/import", s.idempotent(s.ImportTimeEntriesCSV))
+	legacy("/api/time-entries/split-midnight", s.SplitTimeEntryAtMidnight)
+	legacy("/api/time-entries/split", s.SplitTimeEntry)
+	legacy("/api/time-entries/merge", s.MergeTimeEntries)
+	legacy("/api/time-entries/history", s.GetTimeEntryHistory)
+	legacy("/api/time-entries/bulk", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			s.BulkUpdateTimeEntries(w, r)
+		case http.MethodDelete:
+			s.BulkDeleteTimeEntries(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/time-entries/project", s.GetTimeEntriesByProject)
-	mux.HandleFunc("/api/time-entries/billable", s.UpdateTimeEntryBillable)
-	mux.HandleFunc("/api/time-entries/import", s.ImportTimeEntriesCSV)
-	mux.HandleFunc("/api/time-entries/single", func(w http.ResponseWriter, r *http.Request) {
+	legacy("/api/time-entries/single", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			s.GetTimeEntry(w, r)
-		case http.MethodPut:
+		case http.MethodPut, http.MethodPatch:
 			s.UpdateTimeEntry(w, r)
 		case http.MethodDelete:
 			s.DeleteTimeEntry(w, r)
@@ -44,21 +66,31 @@ func (s *Server) SetupRoutes() *http.ServeMux {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/settings", func(w http.ResponseWriter, r *http.Request) {
+	legacy("/api/settings", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			s.GetSettings(w, r)
-		case http.MethodPut:
+		case http.MethodPut, http.MethodPatch:
 			s.UpdateSettings(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/projects/single", func(w http.ResponseWriter, r *http.Request) {
+	legacy("/api/settings/user", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetUserSettings(w, r)
+		case http.MethodPut, http.MethodPatch:
+			s.UpdateUserSettings(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/projects/single", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			s.GetProject(w, r)
-		case http.MethodPut:
+		case http.MethodPut, http.MethodPatch:
 			s.UpdateProject(w, r)
 		case http.MethodDelete:
 			s.DeleteProject(w, r)
@@ -66,8 +98,217 @@ func (s *Server) SetupRoutes() *http.ServeMux {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
-	mux.HandleFunc("/api/reports/pdf", s.GeneratePDFReport)
-	mux.HandleFunc("/api/currencies", s.GetSupportedCurrencies)
+	legacy("/api/clients", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetClients(w, r)
+		case http.MethodPost:
+			s.CreateClient(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/clients/single", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetClient(w, r)
+		case http.MethodPut:
+			s.UpdateClient(w, r)
+		case http.MethodDelete:
+			s.DeleteClient(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetTags(w, r)
+		case http.MethodPost:
+			s.CreateTag(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/branding", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetBranding(w, r)
+		case http.MethodPut:
+			s.UpdateBranding(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/branding/logo", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetBrandingLogo(w, r)
+		case http.MethodPost:
+			s.UploadBrandingLogo(w, r)
+		case http.MethodDelete:
+			s.DeleteBrandingLogo(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetWorkspaces(w, r)
+		case http.MethodPost:
+			s.CreateWorkspace(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/workspaces/members", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetWorkspaceMembers(w, r)
+		case http.MethodPost:
+			s.AddWorkspaceMember(w, r)
+		case http.MethodDelete:
+			s.RemoveWorkspaceMember(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/projects/members", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetProjectMembers(w, r)
+		case http.MethodPost:
+			s.AddProjectMember(w, r)
+		case http.MethodDelete:
+			s.RemoveProjectMember(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/timesheets/week", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.GetWeeklyTimesheet(w, r)
+		case http.MethodPut:
+			s.UpdateWeeklyTimesheet(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	legacy("/api/timesheets/periods", s.GetTimesheetPeriods)
+	legacy("/api/timesheets/period", s.GetTimesheetPeriod)
+	legacy("/api/timesheets/submit", s.SubmitTimesheet)
+	legacy("/api/timesheets/approve", s.ApproveTimesheet)
+	legacy("/api/timesheets/reject", s.RejectTimesheet)
+	legacy("/api/reports/pdf", s.GeneratePDFReport)
+	legacy("/api/reports/export", s.ExportReport)
+	legacy("/api/reports/summary", s.GetReportSummary)
+	legacy("/api/currencies", s.GetSupportedCurrencies)
 
 	return mux
-}
\ No newline at end of file
+}
+
+// setupV1Routes registers the /api/v1 routes, which name resources in the
+// path and dispatch on the method with pattern routing.
+func (s *Server) setupV1Routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/users", s.GetUsers)
+	mux.HandleFunc("GET /api/v1/currencies", s.GetSupportedCurrencies)
+
+	mux.HandleFunc("GET /api/v1/projects", s.GetProjects)
+	mux.HandleFunc("POST /api/v1/projects", s.idempotent(s.CreateProject))
+	mux.HandleFunc("GET /api/v1/projects/{id}", withPathValues(s.GetProject, "id"))
+	mux.HandleFunc("PUT /api/v1/projects/{id}", withPathValues(s.UpdateProject, "id"))
+	mux.HandleFunc("PATCH /api/v1/projects/{id}", withPathValues(s.UpdateProject, "id"))
+	mux.HandleFunc("DELETE /api/v1/projects/{id}", withPathValues(s.DeleteProject, "id"))
+	mux.HandleFunc("GET /api/v1/projects/{project_id}/time-entries", withPathValues(s.GetTimeEntriesByProject, "project_id"))
+	mux.HandleFunc("GET /api/v1/projects/{project_id}/members", withPathValues(s.GetProjectMembers, "project_id"))
+	mux.HandleFunc("POST /api/v1/projects/{project_id}/members", withPathValues(s.AddProjectMember, "project_id"))
+	mux.HandleFunc("DELETE /api/v1/projects/{project_id}/members/{user_id}", withPathValues(s.RemoveProjectMember, "project_id", "user_id"))
+
+	mux.HandleFunc("GET /api/v1/time-entries", s.GetTimeEntries)
+	mux.HandleFunc("POST /api/v1/time-entries", s.idempotent(s.CreateTimeEntry))
+	mux.HandleFunc("POST /api/v1/time-entries/import", s.idempotent(s.ImportTimeEntriesCSV))
+	mux.HandleFunc("POST /api/v1/time-entries/split", s.SplitTimeEntry)
+	mux.HandleFunc("POST /api/v1/time-entries/merge", s.MergeTimeEntries)
+	mux.HandleFunc("PATCH /api/v1/time-entries/bulk", s.BulkUpdateTimeEntries)
+	mux.HandleFunc("DELETE /api/v1/time-entries/bulk", s.BulkDeleteTimeEntries)
+	mux.HandleFunc("GET /api/v1/time-entries/{id}", withPathValues(s.GetTimeEntry, "id"))
+	mux.HandleFunc("PUT /api/v1/time-entries/{id}", withPathValues(s.UpdateTimeEntry, "id"))
+	mux.HandleFunc("PATCH /api/v1/time-entries/{id}", withPathValues(s.UpdateTimeEntry, "id"))
+	mux.HandleFunc("DELETE /api/v1/time-entries/{id}", withPathValues(s.DeleteTimeEntry, "id"))
+	mux.HandleFunc("PUT /api/v1/time-entries/{id}/billable", withPathValues(s.UpdateTimeEntryBillable, "id"))
+	mux.HandleFunc("PUT /api/v1/time-entries/{id}/tags", withPathValues(s.UpdateTimeEntryTags, "id"))
+	mux.HandleFunc("GET /api/v1/time-entries/{id}/history", withPathValues(s.GetTimeEntryHistory, "id"))
+	mux.HandleFunc("POST /api/v1/time-entries/{id}/split-midnight", withPathValues(s.SplitTimeEntryAtMidnight, "id"))
+
+	mux.HandleFunc("GET /api/v1/clients", s.GetClients)
+	mux.HandleFunc("POST /api/v1/clients", s.CreateClient)
+	mux.HandleFunc("GET /api/v1/clients/{id}", withPathValues(s.GetClient, "id"))
+	mux.HandleFunc("PUT /api/v1/clients/{id}", withPathValues(s.UpdateClient, "id"))
+	mux.HandleFunc("DELETE /api/v1/clients/{id}", withPathValues(s.DeleteClient, "id"))
+	mux.HandleFunc("GET /api/v1/clients/{client_id}/branding", withPathValues(s.GetBranding, "client_id"))
+	mux.HandleFunc("PUT /api/v1/clients/{client_id}/branding", withPathValues(s.UpdateBranding, "client_id"))
+	mux.HandleFunc("GET /api/v1/clients/{client_id}/branding/logo", withPathValues(s.GetBrandingLogo, "client_id"))
+	mux.HandleFunc("POST /api/v1/clients/{client_id}/branding/logo", withPathValues(s.UploadBrandingLogo, "client_id"))
+	mux.HandleFunc("DELETE /api/v1/clients/{client_id}/branding/logo", withPathValues(s.DeleteBrandingLogo, "client_id"))
+
+	mux.HandleFunc("GET /api/v1/tags", s.GetTags)
+	mux.HandleFunc("POST /api/v1/tags", s.CreateTag)
+
+	mux.HandleFunc("GET /api/v1/branding", s.GetBranding)
+	mux.HandleFunc("PUT /api/v1/branding", s.UpdateBranding)
+	mux.HandleFunc("GET /api/v1/branding/logo", s.GetBrandingLogo)
+	mux.HandleFunc("POST /api/v1/branding/logo", s.UploadBrandingLogo)
+	mux.HandleFunc("DELETE /api/v1/branding/logo", s.DeleteBrandingLogo)
+
+	mux.HandleFunc("GET /api/v1/settings", s.GetSettings)
+	mux.HandleFunc("PUT /api/v1/settings", s.UpdateSettings)
+	mux.HandleFunc("PATCH /api/v1/settings", s.UpdateSettings)
+	mux.HandleFunc("GET /api/v1/settings/user", s.GetUserSettings)
+	mux.HandleFunc("PUT /api/v1/settings/user", s.UpdateUserSettings)
+	mux.HandleFunc("PATCH /api/v1/settings/user", s.UpdateUserSettings)
+
+	mux.HandleFunc("GET /api/v1/workspaces", s.GetWorkspaces)
+	mux.HandleFunc("POST /api/v1/workspaces", s.CreateWorkspace)
+	mux.HandleFunc("GET /api/v1/workspaces/{workspace_id}/members", withPathValues(s.GetWorkspaceMembers, "workspace_id"))
+	mux.HandleFunc("POST /api/v1/workspaces/{workspace_id}/members", withPathValues(s.AddWorkspaceMember, "workspace_id"))
+	mux.HandleFunc("DELETE /api/v1/workspaces/{workspace_id}/members/{user_id}", withPathValues(s.RemoveWorkspaceMember, "workspace_id", "user_id"))
+
+	mux.HandleFunc("GET /api/v1/timesheets/week", s.GetWeeklyTimesheet)
+	mux.HandleFunc("PUT /api/v1/timesheets/week", s.UpdateWeeklyTimesheet)
+	mux.HandleFunc("GET /api/v1/timesheets/periods", s.GetTimesheetPeriods)
+	mux.HandleFunc("GET /api/v1/timesheets/period", s.GetTimesheetPeriod)
+	mux.HandleFunc("POST /api/v1/timesheets/submit", s.SubmitTimesheet)
+	mux.HandleFunc("POST /api/v1/timesheets/approve", s.ApproveTimesheet)
+	mux.HandleFunc("POST /api/v1/timesheets/reject", s.RejectTimesheet)
+
+	mux.HandleFunc("GET /api/v1/reports/pdf", s.GeneratePDFReport)
+	mux.HandleFunc("GET /api/v1/reports/export", s.ExportReport)
+	mux.HandleFunc("GET /api/v1/reports/summary", s.GetReportSummary)
+}
+
+// withPathValues lets a handler that reads resource IDs from the query
+// string serve a /api/v1 route, by copying the named path wildcards into the
+// query before calling it.
+func withPathValues(handler http.HandlerFunc, names ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for _, name := range names {
+			query.Set(name, r.PathValue(name))
+		}
+		r = r.Clone(r.Context())
+		r.URL.RawQuery = query.Encode()
+		handler(w, r)
+	}
+}
+
+// deprecated marks the responses of a legacy route as deprecated in favour
+// of its /api/v1 counterpart.
+func deprecated(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", `</api/v1>; rel="successor-version"`)
+		handler.ServeHTTP(w, r)
+	})
+}
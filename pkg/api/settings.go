@@ -1,49 +1,265 @@
 package api
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
 )
 
+const settingsColumns = "id, workspace_id, default_hourly_rate, currency, rounding_mode, rounding_minutes, rounding_scope, TO_CHAR(locked_until, 'YYYY-MM-DD') AS locked_until, timezone, week_start_day, working_hours_per_day, date_format, default_billable, created_at, updated_at"
+
+const userSettingsColumns = "user_id, timezone, week_start_day, working_hours_per_day, date_format, default_billable, created_at, updated_at"
+
+// GetSettings returns the settings in effect for the requesting user in the
+// current workspace, or the workspace's own settings with scope=workspace.
+// The ETag is that of the settings returned; UpdateSettings accepts either.
 func (s *Server) GetSettings(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := currentWorkspace(s.db, r, models.RoleViewer)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch settings")
+		return
+	}
+
 	var settings models.Settings
-	query := "SELECT id, default_hourly_rate, currency, created_at, updated_at FROM settings LIMIT 1"
-	err := s.db.Get(&settings, query)
+	if r.URL.Query().Get("scope") == "workspace" {
+		settings, err = loadWorkspaceSettings(s.db, workspaceID)
+	} else {
+		settings, err = s.requestSettings(r, workspaceID)
+	}
 	if err != nil {
 		fmt.Printf("Error fetching settings: %v\n", err)
 		http.Error(w, "Failed to fetch settings", http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(settings)
+	writeWithETag(w, settings)
 }
 
+// UpdateSettings updates the settings of the current workspace. Fields left
+// out of the request keep their value; with PATCH the body is a JSON merge
+// patch, so null also clears optional fields.
 func (s *Server) UpdateSettings(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "PUT" {
+	if r.Method != "PUT" && r.Method != http.MethodPatch {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	var settings models.Settings
-	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+	workspaceID, err := currentWorkspace(s.db, r, models.RoleAdmin)
+	if err != nil {
+		writeAccessError(w, err, "Failed to update settings")
+		return
+	}
+
+	// Locking the workspace keeps its settings, which may not have a row
+	// yet, from changing between checking If-Match and writing them.
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update settings", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	// currentWorkspace has already checked the user.
+	userID, _ := currentUserID(r)
+	var effective models.Settings
+	_, err = tx.Exec("SELECT id FROM workspaces WHERE id = $1 FOR UPDATE", workspaceID)
+	if err == nil {
+		effective, err = loadSettings(tx, workspaceID, userID)
+	}
+	settings := effective
+	if err == nil {
+		settings, err = loadWorkspaceSettings(tx, workspaceID)
+	}
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+		http.Error(w, "Failed to update settings", http.StatusInternalServerError)
+		return
+	}
+	current := settings
+
+	if r.Method == http.MethodPatch {
+		if err := decodeMergePatch(r, &settings); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
 		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
 		return
 	}
 
-	query := `UPDATE settings SET default_hourly_rate = $1, currency = $2, updated_at = NOW() WHERE id = 1 RETURNING id, created_at, updated_at`
-	err := s.db.QueryRow(query, settings.DefaultHourlyRate, settings.Currency).Scan(&settings.ID, &settings.CreatedAt, &settings.UpdatedAt)
+	if settings.RoundingMode == "" {
+		settings.RoundingMode = models.RoundNone
+	}
+	if err := validateRounding(&settings.RoundingMode, settings.RoundingMinutes, &settings.RoundingScope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateLockDate(settings.LockedUntil); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := validatePreferences(&settings.Timezone, &settings.WeekStartDay, &settings.WorkingHoursPerDay, &settings.DateFormat); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Clients may send the ETag of the effective settings they were served
+	// as well as that of the workspace settings.
+	if !ifMatchAccepts(r.Header.Get("If-Match"), etagFor(effective)) && !checkIfMatch(w, r, current) {
+		return
+	}
+
+	query := `INSERT INTO settings (workspace_id, default_hourly_rate, currency, rounding_mode, rounding_minutes, rounding_scope, locked_until, timezone, week_start_day, working_hours_per_day, date_format, default_billable)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (workspace_id) DO UPDATE SET default_hourly_rate = EXCLUDED.default_hourly_rate, currency = EXCLUDED.currency,
+			rounding_mode = EXCLUDED.rounding_mode, rounding_minutes = EXCLUDED.rounding_minutes, rounding_scope = EXCLUDED.rounding_scope,
+			locked_until = EXCLUDED.locked_until, timezone = EXCLUDED.timezone, week_start_day = EXCLUDED.week_start_day,
+			working_hours_per_day = EXCLUDED.working_hours_per_day, date_format = EXCLUDED.date_format,
+			default_billable = EXCLUDED.default_billable, updated_at = NOW()
+		RETURNING id, created_at, updated_at`
+	err = tx.QueryRow(query, workspaceID, settings.DefaultHourlyRate, settings.Currency, settings.RoundingMode, settings.RoundingMinutes, settings.RoundingScope, settings.LockedUntil,
+		settings.Timezone, settings.WeekStartDay, settings.WorkingHoursPerDay, settings.DateFormat, settings.DefaultBillable).Scan(&settings.ID, &settings.CreatedAt, &settings.UpdatedAt)
+	if err == nil {
+		settings, err = loadWorkspaceSettings(tx, workspaceID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error updating settings: %v\n", err)
 		http.Error(w, "Failed to update settings", http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(settings)
+	writeWithETag(w, settings)
+}
+
+// GetUserSettings returns the requesting user's overrides of the workspace
+// settings.
+func (s *Server) GetUserSettings(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch user settings")
+		return
+	}
+
+	settings, err := loadUserSettings(s.db, userID)
+	if err != nil {
+		fmt.Printf("Error fetching user settings: %v\n", err)
+		http.Error(w, "Failed to fetch user settings", http.StatusInternalServerError)
+		return
+	}
+
+	writeWithETag(w, settings)
+}
+
+// UpdateUserSettings replaces the requesting user's overrides with PUT, or
+// changes those in a JSON merge patch with PATCH. A null field inherits the
+// workspace value again.
+func (s *Server) UpdateUserSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPatch {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to update user settings")
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update user settings", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var current models.UserSettings
+	_, err = tx.Exec("SELECT id FROM users WHERE id = $1 FOR UPDATE", userID)
+	if err == nil {
+		current, err = loadUserSettings(tx, userID)
+	}
+	if err != nil {
+		fmt.Printf("Error fetching user settings: %v\n", err)
+		http.Error(w, "Failed to update user settings", http.StatusInternalServerError)
+		return
+	}
+
+	// Rates and currency are billing settings of the workspace, which only
+	// admins change.
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) == nil {
+		for _, name := range []string{"default_hourly_rate", "currency"} {
+			if _, ok := fields[name]; ok {
+				http.Error(w, fmt.Sprintf("%s can only be set in the workspace settings", name), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var settings models.UserSettings
+	if r.Method == http.MethodPatch {
+		settings = current
+		if err := decodeMergePatch(r, &settings); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+	settings.UserID = userID
+
+	if err := validatePreferences(settings.Timezone, settings.WeekStartDay, settings.WorkingHoursPerDay, settings.DateFormat); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
+	query := `INSERT INTO user_settings (user_id, timezone, week_start_day, working_hours_per_day, date_format, default_billable)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE SET timezone = EXCLUDED.timezone, week_start_day = EXCLUDED.week_start_day,
+			working_hours_per_day = EXCLUDED.working_hours_per_day, date_format = EXCLUDED.date_format,
+			default_billable = EXCLUDED.default_billable, updated_at = NOW()
+		RETURNING created_at, updated_at`
+	err = tx.QueryRow(query, userID, settings.Timezone, settings.WeekStartDay,
+		settings.WorkingHoursPerDay, settings.DateFormat, settings.DefaultBillable).Scan(&settings.CreatedAt, &settings.UpdatedAt)
+	if err == nil {
+		settings, err = loadUserSettings(tx, userID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error updating user settings: %v\n", err)
+		http.Error(w, "Failed to update user settings", http.StatusInternalServerError)
+		return
+	}
+
+	writeWithETag(w, settings)
 }
 
 func (s *Server) GetSupportedCurrencies(w http.ResponseWriter, r *http.Request) {
@@ -55,4 +271,113 @@ func (s *Server) GetSupportedCurrencies(w http.ResponseWriter, r *http.Request)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(currencies)
-}
\ No newline at end of file
+}
+
+// loadWorkspaceSettings returns the settings of a workspace, or the defaults
+// when it has none yet.
+func loadWorkspaceSettings(q sqlx.Queryer, workspaceID int) (models.Settings, error) {
+	var settings models.Settings
+	err := sqlx.Get(q, &settings, "SELECT "+settingsColumns+" FROM settings WHERE workspace_id = $1", workspaceID)
+	if err == sql.ErrNoRows {
+		return defaultSettings(workspaceID), nil
+	}
+	return settings, err
+}
+
+func loadUserSettings(q sqlx.Queryer, userID int) (models.UserSettings, error) {
+	var settings models.UserSettings
+	err := sqlx.Get(q, &settings, "SELECT "+userSettingsColumns+" FROM user_settings WHERE user_id = $1", userID)
+	if err == sql.ErrNoRows {
+		return models.UserSettings{UserID: userID}, nil
+	}
+	return settings, err
+}
+
+// loadSettings returns the workspace settings with the user's overrides
+// applied.
+func loadSettings(q sqlx.Queryer, workspaceID, userID int) (models.Settings, error) {
+	settings, err := loadWorkspaceSettings(q, workspaceID)
+	if err != nil {
+		return settings, err
+	}
+
+	userSettings, err := loadUserSettings(q, userID)
+	if err != nil {
+		return settings, err
+	}
+	return settings.WithUser(userSettings), nil
+}
+
+// projectSettings returns the settings in effect for the user in the
+// project's workspace.
+func projectSettings(q sqlx.Queryer, projectID interface{}, userID int) (models.Settings, error) {
+	var workspaceID int
+	if err := sqlx.Get(q, &workspaceID, "SELECT workspace_id FROM projects WHERE id = $1", projectID); err != nil {
+		return models.Settings{}, err
+	}
+	return loadSettings(q, workspaceID, userID)
+}
+
+// userPreferences returns the settings of the user's oldest workspace with
+// the user's own overrides applied.
+func userPreferences(q sqlx.Queryer, userID int) (models.Settings, error) {
+	var workspaceID int
+	if err := sqlx.Get(q, &workspaceID, "SELECT COALESCE(MIN(workspace_id), 0) FROM workspace_members WHERE user_id = $1", userID); err != nil {
+		return models.Settings{}, err
+	}
+	return loadSettings(q, workspaceID, userID)
+}
+
+// userLocation returns the timezone of the user: their own override, else
+// that of their oldest workspace.
+func userLocation(q sqlx.Queryer, userID int) (*time.Location, error) {
+	settings, err := userPreferences(q, userID)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Location(), nil
+}
+
+// requestSettings returns the settings in effect for the requesting user in
+// the workspace.
+func (s *Server) requestSettings(r *http.Request, workspaceID int) (models.Settings, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return models.Settings{}, err
+	}
+	return loadSettings(s.db, workspaceID, userID)
+}
+
+func defaultSettings(workspaceID int) models.Settings {
+	return models.Settings{
+		WorkspaceID:        workspaceID,
+		Currency:           "EUR",
+		RoundingMode:       models.RoundNone,
+		RoundingScope:      models.RoundPerEntry,
+		Timezone:           "UTC",
+		WeekStartDay:       1,
+		WorkingHoursPerDay: 8,
+		DateFormat:         models.SupportedDateFormats[0],
+		DefaultBillable:    true,
+	}
+}
+
+// validatePreferences checks the display and time keeping preferences shared
+// by workspace and user settings. Nil values are not checked.
+func validatePreferences(timezone *string, weekStartDay *int, workingHours *float64, dateFormat *string) error {
+	if timezone != nil {
+		if _, err := time.LoadLocation(*timezone); err != nil || *timezone == "" {
+			return fmt.Errorf("Unknown timezone %q", *timezone)
+		}
+	}
+	if weekStartDay != nil && (*weekStartDay < 0 || *weekStartDay > 6) {
+		return fmt.Errorf("week_start_day must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if workingHours != nil && (*workingHours <= 0 || *workingHours > 24) {
+		return fmt.Errorf("working_hours_per_day must be between 0 and 24")
+	}
+	if dateFormat != nil && !models.IsSupportedDateFormat(*dateFormat) {
+		return fmt.Errorf("Unsupported date_format, use %s", strings.Join(models.SupportedDateFormats, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"side-sync/pkg/models"
+)
+
+type summaryDimension struct {
+	columns []string
+	groupBy []string
+	orderBy string
+}
+
+// summaryDay is the calendar day, in the requesting user's timezone, of the
+// part of an entry counted in a row. Entries crossing midnight are split into
+// one part per day; see summaryFrom.
+const summaryDay = "part.day"
+
+// summaryWeek is the first day of the week summaryDay falls in.
+const summaryWeek = "part.week_start"
+
+var summaryDimensions = map[string]summaryDimension{
+	"day": {
+		columns: []string{"TO_CHAR(" + summaryDay + ", 'YYYY-MM-DD') AS period"},
+		groupBy: []string{summaryDay},
+		orderBy: summaryDay,
+	},
+	// Weeks start on the user's week_start_day and are labelled with the ISO
+	// week of the Monday they contain.
+	"week": {
+		columns: []string{"TO_CHAR(" + summaryWeek + " + (8 - EXTRACT(DOW FROM " + summaryWeek + ")::INT) % 7, 'IYYY-\"W\"IW') AS period"},
+		groupBy: []string{summaryWeek},
+		orderBy: summaryWeek,
+	},
+	"month": {
+		columns: []string{"TO_CHAR(DATE_TRUNC('month', " + summaryDay + "), 'YYYY-MM') AS period"},
+		groupBy: []string{"DATE_TRUNC('month', " + summaryDay + ")"},
+		orderBy: "DATE_TRUNC('month', " + summaryDay + ")",
+	},
+	"project": {
+		columns: []string{"p.id AS project_id", "p.name AS project_name"},
+		groupBy: []string{"p.id", "p.name"},
+		orderBy: "p.name",
+	},
+	"client": {
+		columns: []string{"c.id AS client_id", "c.name AS client_name"},
+		groupBy: []string{"c.id", "c.name"},
+		orderBy: "c.name",
+	},
+	"tag": {
+		columns: []string{"t.id AS tag_id", "t.name AS tag_name"},
+		groupBy: []string{"t.id", "t.name"},
+		orderBy: "t.name",
+	},
+	"billable": {
+		columns: []string{"te.billable AS billable"},
+		groupBy: []string{"te.billable"},
+		orderBy: "te.billable DESC",
+	},
+}
+
+// Totals use the durations after the rounding policy of each entry's project
+// has been applied; see the rounded_time_entries view. Each entry counts with
+// the share of it that falls on the day of its part.
+const summaryTotalsColumns = `COALESCE(SUM(rte.duration * part.share), 0) / 3600.0 AS hours,
+	COALESCE(SUM(CASE WHEN te.billable THEN rte.duration * part.share ELSE 0 END), 0) / 3600.0 AS billable_hours,
+	COALESCE(SUM(CASE WHEN te.billable THEN rte.duration * part.share / 3600.0 * COALESCE(p.hourly_rate, s.default_hourly_rate, 0) ELSE 0 END), 0) AS amount`
+
+// summaryFrom expects the requesting user as $1, whose timezone and week
+// start apply. Every entry is split into one part per calendar day it spans
+// in the user's timezone, with the share of the entry's time that falls on
+// that day. Amounts use the rates of the entry's project and workspace.
+const summaryFrom = ` FROM time_entries te
+	JOIN rounded_time_entries rte ON rte.id = te.id
+	JOIN projects p ON p.id = te.project_id
+	LEFT JOIN clients c ON c.id = p.client_id
+	LEFT JOIN settings s ON s.workspace_id = p.workspace_id
+	LEFT JOIN user_settings us ON us.user_id = $1
+	CROSS JOIN LATERAL (
+		SELECT d::DATE AS day,
+			d::DATE - (EXTRACT(DOW FROM d)::INT - pref.week_start_day + 7) % 7 AS week_start,
+			CASE WHEN te.end_time IS NULL OR te.end_time <= te.start_time THEN 1
+			ELSE EXTRACT(EPOCH FROM LEAST(te.end_time, (d + INTERVAL '1 day') AT TIME ZONE pref.timezone) - GREATEST(te.start_time, d AT TIME ZONE pref.timezone))
+				/ EXTRACT(EPOCH FROM te.end_time - te.start_time) END AS share
+		FROM (SELECT COALESCE(us.timezone, s.timezone, 'UTC') AS timezone, COALESCE(us.week_start_day, s.week_start_day, 1) AS week_start_day) pref,
+			generate_series(DATE(te.start_time AT TIME ZONE pref.timezone)::TIMESTAMP,
+				DATE(GREATEST(te.end_time - INTERVAL '1 microsecond', te.start_time) AT TIME ZONE pref.timezone)::TIMESTAMP,
+				INTERVAL '1 day') d
+	) part`
+
+func (s *Server) GetReportSummary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var groupBy []string
+	timeDimensions := 0
+	seen := make(map[string]bool)
+	for _, key := range strings.Split(r.URL.Query().Get("group_by"), ",") {
+		key = strings.TrimSpace(key)
+		if key == "" || seen[key] {
+			continue
+		}
+		if _, ok := summaryDimensions[key]; !ok {
+			http.Error(w, fmt.Sprintf("Invalid group_by value '%s'", key), http.StatusBadRequest)
+			return
+		}
+		if key == "day" || key == "week" || key == "month" {
+			timeDimensions++
+		}
+		seen[key] = true
+		groupBy = append(groupBy, key)
+	}
+
+	if timeDimensions > 1 {
+		http.Error(w, "Only one of day, week or month can be used in group_by", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch summary")
+		return
+	}
+
+	dateFrom := r.URL.Query().Get("date_from")
+	dateTo := r.URL.Query().Get("date_to")
+
+	where := " WHERE " + memberWorkspacesClause("p.workspace_id", 1)
+	args := []interface{}{userID}
+	argIndex := 2
+
+	if dateFrom != "" {
+		where += fmt.Sprintf(" AND "+summaryDay+" >= $%d", argIndex)
+		args = append(args, dateFrom)
+		argIndex++
+	}
+
+	if dateTo != "" {
+		where += fmt.Sprintf(" AND "+summaryDay+" <= $%d", argIndex)
+		args = append(args, dateTo)
+		argIndex++
+	}
+
+	if projectID := r.URL.Query().Get("project_id"); projectID != "" {
+		where += fmt.Sprintf(" AND te.project_id = $%d", argIndex)
+		args = append(args, projectID)
+		argIndex++
+	}
+
+	if clientID := r.URL.Query().Get("client_id"); clientID != "" {
+		where += fmt.Sprintf(" AND p.client_id = $%d", argIndex)
+		args = append(args, clientID)
+		argIndex++
+	}
+
+	billableFilter := r.URL.Query().Get("billable")
+	if billableFilter == "billable" {
+		where += fmt.Sprintf(" AND te.billable = $%d", argIndex)
+		args = append(args, true)
+	} else if billableFilter == "non-billable" {
+		where += fmt.Sprintf(" AND te.billable = $%d", argIndex)
+		args = append(args, false)
+	}
+
+	summary := models.Summary{
+		GroupBy:  groupBy,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Currency: "EUR",
+		Groups:   []models.SummaryGroup{},
+	}
+	if summary.GroupBy == nil {
+		summary.GroupBy = []string{}
+	}
+
+	if workspaceID, err := currentWorkspace(s.db, r, models.RoleViewer); err == nil {
+		if settings, err := s.requestSettings(r, workspaceID); err == nil && settings.Currency != "" {
+			summary.Currency = settings.Currency
+		}
+	}
+
+	// Totals are computed without the tag join so entries with several tags
+	// are only counted once.
+	err = s.db.Get(&summary.Totals, "SELECT "+summaryTotalsColumns+summaryFrom+where, args...)
+	if err != nil {
+		fmt.Printf("Error fetching summary totals: %v\n", err)
+		http.Error(w, "Failed to fetch summary", http.StatusInternalServerError)
+		return
+	}
+
+	if len(groupBy) > 0 {
+		var columns, groupColumns, orderColumns []string
+		for _, key := range groupBy {
+			dimension := summaryDimensions[key]
+			columns = append(columns, dimension.columns...)
+			groupColumns = append(groupColumns, dimension.groupBy...)
+			orderColumns = append(orderColumns, dimension.orderBy)
+		}
+
+		from := summaryFrom
+		if seen["tag"] {
+			from += `
+	LEFT JOIN time_entry_tags tet ON tet.time_entry_id = te.id
+	LEFT JOIN tags t ON t.id = tet.tag_id`
+		}
+
+		query := "SELECT " + strings.Join(columns, ", ") + ", " + summaryTotalsColumns + from + where +
+			" GROUP BY " + strings.Join(groupColumns, ", ") +
+			" ORDER BY " + strings.Join(orderColumns, ", ")
+
+		if err := s.db.Select(&summary.Groups, query, args...); err != nil {
+			fmt.Printf("Error fetching summary groups: %v\n", err)
+			http.Error(w, "Failed to fetch summary", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func (s *Server) GetTags(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch tags")
+		return
+	}
+
+	var tags []models.Tag
+	err = s.db.Select(&tags, "SELECT id, workspace_id, name, created_at FROM tags WHERE "+memberWorkspacesClause("workspace_id", 1)+" ORDER BY name ASC", userID)
+	if err != nil {
+		fmt.Printf("Error fetching tags: %v\n", err)
+		http.Error(w, "Failed to fetch tags", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tags)
+}
+
+func (s *Server) CreateTag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	workspaceID, err := currentWorkspace(s.db, r, models.RoleMember)
+	if err != nil {
+		writeAccessError(w, err, "Failed to create tag")
+		return
+	}
+
+	var tag models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&tag); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	tag.Name = strings.TrimSpace(tag.Name)
+	if tag.Name == "" {
+		http.Error(w, "Tag name is required", http.StatusBadRequest)
+		return
+	}
+
+	tag.WorkspaceID = workspaceID
+	query := `INSERT INTO tags (workspace_id, name) VALUES ($1, $2) ON CONFLICT (workspace_id, name) DO UPDATE SET name = EXCLUDED.name RETURNING id, created_at`
+	err = s.db.QueryRow(query, tag.WorkspaceID, tag.Name).Scan(&tag.ID, &tag.CreatedAt)
+	if err != nil {
+		fmt.Printf("Error creating tag: %v\n", err)
+		http.Error(w, "Failed to create tag", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(tag)
+}
+
+func (s *Server) UpdateTimeEntryTags(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	timeEntryID := r.URL.Query().Get("id")
+	if timeEntryID == "" {
+		http.Error(w, "Time entry ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var requestBody struct {
+		Tags []string `json:"tags"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update time entry tags", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	current, err := lockTimeEntry(tx, timeEntryID)
+	if err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
+	if err := s.checkStoredEntryLock(tx, r, timeEntryID); err != nil {
+		writeLockError(w, err, "Failed to update time entry tags")
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
+	tags, err := setTimeEntryTags(tx, current.ID, requestBody.Tags)
+	var updated models.TimeEntry
+	if err == nil {
+		updated, err = loadTimeEntry(tx, timeEntryID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error updating time entry tags: %v\n", err)
+		http.Error(w, "Failed to update time entry tags", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etagFor(updated))
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"tags":    tags,
+	})
+}
+
+func setTimeEntryTags(q sqlx.Ext, timeEntryID int, names []string) ([]string, error) {
+	if _, err := q.Exec("DELETE FROM time_entry_tags WHERE time_entry_id = $1", timeEntryID); err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		// Tags are created in the workspace of the entry's project.
+		var tagID int
+		err := q.QueryRowx(`INSERT INTO tags (workspace_id, name) SELECT p.workspace_id, $2 FROM time_entries te JOIN projects p ON p.id = te.project_id WHERE te.id = $1 ON CONFLICT (workspace_id, name) DO UPDATE SET name = EXCLUDED.name RETURNING id`, timeEntryID, name).Scan(&tagID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = q.Exec(`INSERT INTO time_entry_tags (time_entry_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`, timeEntryID, tagID)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, name)
+	}
+
+	return tags, nil
+}
+
+// loadTimeEntryTags fills the Tags field of the given entries in place.
+func loadTimeEntryTags(q sqlx.Queryer, timeEntries []models.TimeEntry) error {
+	if len(timeEntries) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(timeEntries))
+	for i, entry := range timeEntries {
+		ids[i] = entry.ID
+	}
+
+	var rows []struct {
+		TimeEntryID int    `db:"time_entry_id"`
+		Name        string `db:"name"`
+	}
+	query := "SELECT tet.time_entry_id, t.name FROM time_entry_tags tet JOIN tags t ON t.id = tet.tag_id WHERE tet.time_entry_id = ANY($1) ORDER BY t.name ASC"
+	if err := sqlx.Select(q, &rows, query, pq.Array(ids)); err != nil {
+		return err
+	}
+
+	tagsByEntry := make(map[int][]string)
+	for _, row := range rows {
+		tagsByEntry[row.TimeEntryID] = append(tagsByEntry[row.TimeEntryID], row.Name)
+	}
+
+	for i := range timeEntries {
+		timeEntries[i].Tags = tagsByEntry[timeEntries[i].ID]
+	}
+
+	return nil
+}
@@ -4,17 +4,29 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
 )
 
 func (s *Server) GetTimeEntries(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch time entries")
+		return
+	}
+
 	var timeEntries []models.TimeEntry
-	err := s.db.Select(&timeEntries, "SELECT id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at FROM time_entries ORDER BY start_time DESC")
+	err = s.db.Select(&timeEntries, "SELECT id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at FROM time_entries WHERE project_id IN (SELECT id FROM projects WHERE "+memberWorkspacesClause("workspace_id", 1)+") ORDER BY start_time DESC", userID)
+	if err == nil {
+		err = loadTimeEntryTags(s.db, timeEntries)
+	}
 	if err != nil {
 		fmt.Println(err)
 		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
@@ -31,14 +43,65 @@ func (s *Server) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+
 	var timeEntry models.TimeEntry
-	if err := json.NewDecoder(r.Body).Decode(&timeEntry); err != nil {
+	var fields struct {
+		Billable *bool `json:"billable"`
+	}
+	if err := json.Unmarshal(body, &timeEntry); err != nil {
 		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
 		return
 	}
+	json.Unmarshal(body, &fields)
+
+	if timeEntry.UserID == 0 {
+		userID, err := currentUserID(r)
+		if err != nil {
+			writeAccessError(w, err, "Failed to create time entry")
+			return
+		}
+		timeEntry.UserID = userID
+	}
+
+	if err := s.checkEntryLock(s.db, r, timeEntry.UserID, timeEntry.ProjectID, timeEntry.StartTime); err != nil {
+		writeLockError(w, err, "Failed to create time entry")
+		return
+	}
+
+	// Entries that do not say whether they are billable follow the settings.
+	if fields.Billable == nil {
+		settings, err := projectSettings(s.db, timeEntry.ProjectID, timeEntry.UserID)
+		if err != nil {
+			fmt.Printf("Error fetching settings: %v\n", err)
+			http.Error(w, "Failed to create time entry", http.StatusInternalServerError)
+			return
+		}
+		timeEntry.Billable = settings.DefaultBillable
+	}
+
+	// The entry and its tags are stored together, so a failed request leaves
+	// nothing behind that a retry would duplicate.
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to create time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
 
 	query := `INSERT INTO time_entries (project_id, user_id, description, start_time, end_time, duration, billable) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`
-	err := s.db.QueryRow(query, timeEntry.ProjectID, timeEntry.UserID, timeEntry.Description, timeEntry.StartTime, timeEntry.EndTime, timeEntry.Duration, timeEntry.Billable).Scan(&timeEntry.ID, &timeEntry.CreatedAt, &timeEntry.UpdatedAt)
+	err = tx.QueryRow(query, timeEntry.ProjectID, timeEntry.UserID, timeEntry.Description, timeEntry.StartTime, timeEntry.EndTime, timeEntry.Duration, timeEntry.Billable).Scan(&timeEntry.ID, &timeEntry.CreatedAt, &timeEntry.UpdatedAt)
+	if err == nil && timeEntry.Tags != nil {
+		timeEntry.Tags, err = setTimeEntryTags(tx, timeEntry.ID, timeEntry.Tags)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error creating time entry: %v\n", err)
 		http.Error(w, "Failed to create time entry", http.StatusInternalServerError)
@@ -57,6 +120,20 @@ func (s *Server) GetTimeEntriesByProject(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	userID, _, err := authorizeProject(s.db, r, projectID, models.RoleViewer)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch time entries")
+		return
+	}
+
+	// Dates are compared in the requesting user's timezone.
+	settings, err := projectSettings(s.db, projectID, userID)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
+		return
+	}
+
 	dateFrom := r.URL.Query().Get("date_from")
 	dateTo := r.URL.Query().Get("date_to")
 	billableFilter := r.URL.Query().Get("billable")
@@ -66,15 +143,15 @@ func (s *Server) GetTimeEntriesByProject(w http.ResponseWriter, r *http.Request)
 	argIndex := 2
 
 	if dateFrom != "" {
-		query += fmt.Sprintf(" AND DATE(start_time) >= $%d", argIndex)
-		args = append(args, dateFrom)
-		argIndex++
+		query += fmt.Sprintf(" AND DATE(start_time AT TIME ZONE $%d) >= $%d", argIndex, argIndex+1)
+		args = append(args, settings.Location().String(), dateFrom)
+		argIndex += 2
 	}
 
 	if dateTo != "" {
-		query += fmt.Sprintf(" AND DATE(start_time) <= $%d", argIndex)
-		args = append(args, dateTo)
-		argIndex++
+		query += fmt.Sprintf(" AND DATE(start_time AT TIME ZONE $%d) <= $%d", argIndex, argIndex+1)
+		args = append(args, settings.Location().String(), dateTo)
+		argIndex += 2
 	}
 
 	if billableFilter == "billable" {
@@ -88,7 +165,10 @@ func (s *Server) GetTimeEntriesByProject(w http.ResponseWriter, r *http.Request)
 	query += " ORDER BY start_time DESC"
 
 	var timeEntries []models.TimeEntry
-	err := s.db.Select(&timeEntries, query, args...)
+	err = s.db.Select(&timeEntries, query, args...)
+	if err == nil {
+		err = loadTimeEntryTags(s.db, timeEntries)
+	}
 	if err != nil {
 		fmt.Printf("Error fetching time entries: %v\n", err)
 		http.Error(w, "Failed to fetch time entries", http.StatusInternalServerError)
@@ -120,8 +200,38 @@ func (s *Server) UpdateTimeEntryBillable(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	current, err := lockTimeEntry(tx, timeEntryID)
+	if err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
+	if err := s.checkStoredEntryLock(tx, r, timeEntryID); err != nil {
+		writeLockError(w, err, "Failed to update time entry")
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
 	query := `UPDATE time_entries SET billable = $1, updated_at = NOW() WHERE id = $2`
-	_, err := s.db.Exec(query, requestBody.Billable, timeEntryID)
+	_, err = tx.Exec(query, requestBody.Billable, timeEntryID)
+	var updated models.TimeEntry
+	if err == nil {
+		updated, err = loadTimeEntry(tx, timeEntryID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error updating time entry billable status: %v\n", err)
 		http.Error(w, "Failed to update time entry", http.StatusInternalServerError)
@@ -129,6 +239,7 @@ func (s *Server) UpdateTimeEntryBillable(w http.ResponseWriter, r *http.Request)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etagFor(updated))
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success":  true,
 		"billable": requestBody.Billable,
@@ -159,6 +270,18 @@ func (s *Server) ImportTimeEntriesCSV(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to import time entries")
+		return
+	}
+
+	settings, err := projectSettings(s.db, projectID, userID)
+	if err != nil {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
 	file, _, err := r.FormFile("csv_file")
 	if err != nil {
 		http.Error(w, "Failed to get uploaded file", http.StatusBadRequest)
@@ -178,62 +301,91 @@ func (s *Server) ImportTimeEntriesCSV(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Rows that cannot be read are reported back instead of being imported.
+	type rejectedRow struct {
+		Row   int    `json:"row"`
+		Error string `json:"error"`
+	}
+	rejected := []rejectedRow{}
+
 	var timeEntries []models.TimeEntry
 	for i, record := range records[1:] {
 		if len(record) < 2 {
-			fmt.Printf("Skipping row %d: insufficient columns\n", i+2)
+			rejected = append(rejected, rejectedRow{i + 2, "Insufficient columns"})
 			continue
 		}
 
 		dateStr := strings.TrimSpace(record[0])
 		date, err := time.Parse("2006-01-02", dateStr)
 		if err != nil {
-			fmt.Printf("Skipping row %d: invalid date format '%s'\n", i+2, dateStr)
+			rejected = append(rejected, rejectedRow{i + 2, fmt.Sprintf("Invalid date format '%s'", dateStr)})
 			continue
 		}
 
 		durationStr := strings.TrimSpace(record[1])
 		durationHours, err := strconv.ParseFloat(durationStr, 64)
 		if err != nil || durationHours <= 0 {
-			fmt.Printf("Skipping row %d: invalid duration '%s'\n", i+2, durationStr)
+			rejected = append(rejected, rejectedRow{i + 2, fmt.Sprintf("Invalid duration '%s'", durationStr)})
 			continue
 		}
 
 		durationSeconds := int(durationHours * 3600)
 
-		startTime := time.Date(date.Year(), date.Month(), date.Day(), 17, 0, 0, 0, date.Location())
+		startTime := time.Date(date.Year(), date.Month(), date.Day(), 17, 0, 0, 0, settings.Location())
 
 		endTime := startTime.Add(time.Duration(durationSeconds) * time.Second)
 
 		timeEntry := models.TimeEntry{
 			ProjectID:   projectID,
-			UserID:      1,
+			UserID:      userID,
 			Description: "Imported from CSV",
 			StartTime:   startTime,
 			EndTime:     &endTime,
 			Duration:    &durationSeconds,
-			Billable:    true,
+			Billable:    settings.DefaultBillable,
 		}
 
 		timeEntries = append(timeEntries, timeEntry)
 	}
 
-	var createdCount int
+	// The valid rows are imported together or not at all.
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to import time entries", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	// The import is refused as a whole when any row falls into a locked period.
+	for _, entry := range timeEntries {
+		if err := s.checkEntryLock(tx, r, entry.UserID, entry.ProjectID, entry.StartTime); err != nil {
+			writeLockError(w, err, "Failed to import time entries")
+			return
+		}
+	}
+
 	for _, entry := range timeEntries {
 		query := `INSERT INTO time_entries (project_id, user_id, description, start_time, end_time, duration, billable) VALUES ($1, $2, $3, $4, $5, $6, $7)`
-		_, err := s.db.Exec(query, entry.ProjectID, entry.UserID, entry.Description, entry.StartTime, entry.EndTime, entry.Duration, entry.Billable)
-		if err != nil {
-			fmt.Printf("Error inserting time entry: %v\n", err)
-			continue
+		if _, err = tx.Exec(query, entry.ProjectID, entry.UserID, entry.Description, entry.StartTime, entry.EndTime, entry.Duration, entry.Billable); err != nil {
+			break
 		}
-		createdCount++
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error importing time entries: %v\n", err)
+		http.Error(w, "Failed to import time entries", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success":        true,
-		"message":        fmt.Sprintf("Successfully imported %d time entries", createdCount),
-		"imported_count": createdCount,
+		"message":        fmt.Sprintf("Successfully imported %d time entries", len(timeEntries)),
+		"imported_count": len(timeEntries),
+		"rejected_rows":  rejected,
 	})
 }
 
@@ -249,21 +401,46 @@ func (s *Server) GetTimeEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var timeEntry models.TimeEntry
-	query := "SELECT id, project_id, user_id, description, start_time, end_time, duration, billable, created_at, updated_at FROM time_entries WHERE id = $1"
-	err := s.db.Get(&timeEntry, query, timeEntryID)
+	timeEntry, err := loadTimeEntry(s.db, timeEntryID)
 	if err != nil {
 		fmt.Printf("Error fetching time entry: %v\n", err)
 		http.Error(w, "Time entry not found", http.StatusNotFound)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(timeEntry)
+	if _, _, err := authorizeProject(s.db, r, timeEntry.ProjectID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch time entry")
+		return
+	}
+
+	writeWithETag(w, timeEntry)
 }
 
+// loadTimeEntry returns a time entry with its tags, as it is served and
+// versioned by its ETag.
+func loadTimeEntry(q sqlx.Queryer, timeEntryID interface{}) (models.TimeEntry, error) {
+	entries := []models.TimeEntry{{}}
+	if err := sqlx.Get(q, &entries[0], "SELECT "+timeEntryColumns+" FROM time_entries WHERE id = $1", timeEntryID); err != nil {
+		return entries[0], err
+	}
+	err := loadTimeEntryTags(q, entries)
+	return entries[0], err
+}
+
+// lockTimeEntry loads a time entry and locks it until tx ends, so it cannot
+// change between checking If-Match and writing it.
+func lockTimeEntry(tx *sqlx.Tx, timeEntryID interface{}) (models.TimeEntry, error) {
+	var id int
+	if err := tx.Get(&id, "SELECT id FROM time_entries WHERE id = $1 FOR UPDATE", timeEntryID); err != nil {
+		return models.TimeEntry{}, err
+	}
+	return loadTimeEntry(tx, id)
+}
+
+// UpdateTimeEntry replaces a time entry with PUT, or changes the fields in a
+// JSON merge patch with PATCH.
 func (s *Server) UpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "PUT" {
+	if r.Method != "PUT" && r.Method != http.MethodPatch {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
@@ -274,22 +451,109 @@ func (s *Server) UpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	current, err := lockTimeEntry(tx, timeEntryID)
+	if err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
 	var timeEntry models.TimeEntry
-	if err := json.NewDecoder(r.Body).Decode(&timeEntry); err != nil {
+	if r.Method == http.MethodPatch {
+		timeEntry = current
+		if err := decodeMergePatch(r, &timeEntry); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// A patch without "tags" keeps the current ones, so no tags left
+		// after merging means "tags": null removed them or there were none.
+		if timeEntry.Tags == nil {
+			timeEntry.Tags = []string{}
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&timeEntry); err != nil {
 		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
 		return
 	}
 
-	query := `UPDATE time_entries SET project_id = $1, description = $2, start_time = $3, end_time = $4, duration = $5, billable = $6, updated_at = NOW() WHERE id = $7 RETURNING id, created_at, updated_at`
-	err := s.db.QueryRow(query, timeEntry.ProjectID, timeEntry.Description, timeEntry.StartTime, timeEntry.EndTime, timeEntry.Duration, timeEntry.Billable, timeEntryID).Scan(&timeEntry.ID, &timeEntry.CreatedAt, &timeEntry.UpdatedAt)
+	if err := reconcileTimeEntry(current, &timeEntry); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The entry may neither be taken out of nor moved into a locked period.
+	err = s.checkEntryLock(tx, r, current.UserID, current.ProjectID, current.StartTime)
+	if err == nil {
+		err = s.checkEntryLock(tx, r, current.UserID, timeEntry.ProjectID, timeEntry.StartTime)
+	}
+	if err != nil {
+		writeLockError(w, err, "Failed to update time entry")
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
+	query := `UPDATE time_entries SET project_id = $1, description = $2, start_time = $3, end_time = $4, duration = $5, billable = $6, updated_at = NOW() WHERE id = $7 RETURNING id, user_id, created_at, updated_at`
+	err = tx.QueryRow(query, timeEntry.ProjectID, timeEntry.Description, timeEntry.StartTime, timeEntry.EndTime, timeEntry.Duration, timeEntry.Billable, timeEntryID).Scan(&timeEntry.ID, &timeEntry.UserID, &timeEntry.CreatedAt, &timeEntry.UpdatedAt)
+	if err == nil && timeEntry.Tags != nil {
+		timeEntry.Tags, err = setTimeEntryTags(tx, timeEntry.ID, timeEntry.Tags)
+	}
+	if err == nil {
+		timeEntry, err = loadTimeEntry(tx, timeEntryID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error updating time entry: %v\n", err)
 		http.Error(w, "Failed to update time entry", http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(timeEntry)
+	writeWithETag(w, timeEntry)
+}
+
+// reconcileTimeEntry checks the required fields of an updated entry and keeps
+// its duration in line with its start and end. When the start or end changes
+// and the duration was left as it was, the duration follows them; a duration
+// that contradicts them is rejected.
+func reconcileTimeEntry(current models.TimeEntry, updated *models.TimeEntry) error {
+	if updated.ProjectID == 0 {
+		return fmt.Errorf("project_id is required")
+	}
+	if updated.StartTime.IsZero() {
+		return fmt.Errorf("start_time is required")
+	}
+	if updated.EndTime == nil {
+		return nil
+	}
+	if updated.EndTime.Before(updated.StartTime) {
+		return fmt.Errorf("end_time must not be before start_time")
+	}
+
+	moved := !updated.StartTime.Equal(current.StartTime) || current.EndTime == nil || !updated.EndTime.Equal(*current.EndTime)
+	if !moved {
+		return nil
+	}
+
+	duration := int(updated.EndTime.Sub(updated.StartTime).Seconds())
+	kept := updated.Duration == nil || (current.Duration != nil && *updated.Duration == *current.Duration)
+	if kept {
+		updated.Duration = &duration
+		return nil
+	}
+	if *updated.Duration != duration {
+		return fmt.Errorf("duration does not match start_time and end_time")
+	}
+	return nil
 }
 
 func (s *Server) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
@@ -304,8 +568,34 @@ func (s *Server) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to delete time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	current, err := lockTimeEntry(tx, timeEntryID)
+	if err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
+	if err := s.checkStoredEntryLock(tx, r, timeEntryID); err != nil {
+		writeLockError(w, err, "Failed to delete time entry")
+		return
+	}
+
+	if !checkIfMatch(w, r, current) {
+		return
+	}
+
 	query := `DELETE FROM time_entries WHERE id = $1`
-	_, err := s.db.Exec(query, timeEntryID)
+	_, err = tx.Exec(query, timeEntryID)
+	if err == nil {
+		err = tx.Commit()
+	}
 	if err != nil {
 		fmt.Printf("Error deleting time entry: %v\n", err)
 		http.Error(w, "Failed to delete time entry", http.StatusInternalServerError)
@@ -319,3 +609,71 @@ func (s *Server) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// SplitTimeEntryAtMidnight stores a time entry that crosses midnight as one
+// entry per calendar day in the timezone of the entry's user.
+func (s *Server) SplitTimeEntryAtMidnight(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	timeEntryID, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, "Time entry ID is required", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to split time entry", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	var userID int
+	if err := tx.Get(&userID, "SELECT user_id FROM time_entries WHERE id = $1", timeEntryID); err != nil {
+		http.Error(w, "Time entry not found", http.StatusNotFound)
+		return
+	}
+
+	loc, err := userLocation(tx, userID)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+		http.Error(w, "Failed to split time entry", http.StatusInternalServerError)
+		return
+	}
+
+	parts, err := s.splitEntryAtMidnight(tx, r, timeEntryID, loc)
+	if err != nil {
+		writeLockError(w, err, "Failed to split time entry")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		fmt.Printf("Error splitting time entry: %v\n", err)
+		http.Error(w, "Failed to split time entry", http.StatusInternalServerError)
+		return
+	}
+
+	if err := loadTimeEntryTags(s.db, parts); err != nil {
+		fmt.Printf("Error fetching time entry tags: %v\n", err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(parts)
+}
+
+// splitEntryAtMidnight splits a stored time entry at every midnight in loc
+// with storeSplitEntry. It returns the parts in order.
+func (s *Server) splitEntryAtMidnight(tx *sqlx.Tx, r *http.Request, timeEntryID int, loc *time.Location) ([]models.TimeEntry, error) {
+	var entry models.TimeEntry
+	if err := tx.Get(&entry, "SELECT "+timeEntryColumns+" FROM time_entries WHERE id = $1 FOR UPDATE", timeEntryID); err != nil {
+		return nil, err
+	}
+
+	parts := models.SplitAtMidnight(entry, loc)
+	if err := s.storeSplitEntry(tx, r, entry, parts); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
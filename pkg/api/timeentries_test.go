@@ -0,0 +1,52 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"side-sync/pkg/models"
+)
+
+func TestReconcileTimeEntry(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	duration := 3600
+	current := models.TimeEntry{ID: 1, ProjectID: 2, StartTime: start, EndTime: &end, Duration: &duration}
+
+	later := end.Add(30 * time.Minute)
+	wrong := 600
+
+	tests := []struct {
+		name     string
+		change   func(*models.TimeEntry)
+		wantErr  bool
+		duration int
+	}{
+		{"unchanged", func(e *models.TimeEntry) {}, false, 3600},
+		{"end moved", func(e *models.TimeEntry) { e.EndTime = &later }, false, 5400},
+		{"start moved", func(e *models.TimeEntry) { e.StartTime = start.Add(-time.Hour) }, false, 7200},
+		{"contradicting duration", func(e *models.TimeEntry) { e.EndTime = &later; e.Duration = &wrong }, true, 0},
+		{"end before start", func(e *models.TimeEntry) { e.StartTime = later }, true, 0},
+		{"start cleared", func(e *models.TimeEntry) { e.StartTime = time.Time{} }, true, 0},
+		{"project cleared", func(e *models.TimeEntry) { e.ProjectID = 0 }, true, 0},
+	}
+	for _, test := range tests {
+		updated := current
+		test.change(&updated)
+
+		err := reconcileTimeEntry(current, &updated)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: got no error, want one", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if *updated.Duration != test.duration {
+			t.Errorf("%s: got duration %d, want %d", test.name, *updated.Duration, test.duration)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const timesheetPeriodColumns = "id, user_id, TO_CHAR(week_start, 'YYYY-MM-DD') AS week_start, status, comment, submitted_at, reviewed_at, reviewer_id, created_at, updated_at"
+
+// timesheetTransitions lists the states a timesheet period may move to a new
+// state from.
+var timesheetTransitions = map[string][]string{
+	models.TimesheetSubmitted: {models.TimesheetOpen, models.TimesheetRejected},
+	models.TimesheetApproved:  {models.TimesheetSubmitted},
+	models.TimesheetRejected:  {models.TimesheetSubmitted},
+}
+
+const approvedPeriodMessage = "Time entry belongs to an approved timesheet"
+
+func (s *Server) GetTimesheetPeriods(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	currentUser, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch timesheet periods")
+		return
+	}
+
+	// Users see their own periods and those of the members of the workspaces
+	// they administer.
+	query := "SELECT " + timesheetPeriodColumns + ` FROM timesheet_periods WHERE (user_id = $1 OR user_id IN (
+		SELECT target.user_id FROM workspace_members me
+		JOIN workspace_members target ON target.workspace_id = me.workspace_id
+		WHERE me.user_id = $1 AND me.role IN ('owner', 'admin')))`
+	args := []interface{}{currentUser}
+	argIndex := 2
+
+	if userID := r.URL.Query().Get("user_id"); userID != "" {
+		query += fmt.Sprintf(" AND user_id = $%d", argIndex)
+		args = append(args, userID)
+		argIndex++
+	}
+
+	if status := r.URL.Query().Get("status"); status != "" {
+		query += fmt.Sprintf(" AND status = $%d", argIndex)
+		args = append(args, status)
+	}
+
+	query += " ORDER BY week_start DESC, user_id ASC"
+
+	periods := []models.TimesheetPeriod{}
+	if err := s.db.Select(&periods, query, args...); err != nil {
+		fmt.Printf("Error fetching timesheet periods: %v\n", err)
+		http.Error(w, "Failed to fetch timesheet periods", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(periods)
+}
+
+func (s *Server) GetTimesheetPeriod(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, day, err := timesheetWeek(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := authorizeUser(s.db, r, userID); err != nil {
+		writeAccessError(w, err, "Failed to fetch timesheet period")
+		return
+	}
+
+	var period models.TimesheetPeriod
+	weekStart, _, err := userWeek(s.db, userID, day)
+	if err == nil {
+		period, err = loadTimesheetPeriod(s.db, userID, weekStart)
+	}
+	if err != nil {
+		fmt.Printf("Error fetching timesheet period: %v\n", err)
+		http.Error(w, "Failed to fetch timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(period)
+}
+
+func (s *Server) SubmitTimesheet(w http.ResponseWriter, r *http.Request) {
+	s.transitionTimesheet(w, r, models.TimesheetSubmitted)
+}
+
+func (s *Server) ApproveTimesheet(w http.ResponseWriter, r *http.Request) {
+	s.transitionTimesheet(w, r, models.TimesheetApproved)
+}
+
+func (s *Server) RejectTimesheet(w http.ResponseWriter, r *http.Request) {
+	s.transitionTimesheet(w, r, models.TimesheetRejected)
+}
+
+func (s *Server) transitionTimesheet(w http.ResponseWriter, r *http.Request, status string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, day, err := timesheetWeek(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Users submit their own weeks; admins and owners review the weeks of
+	// their workspace members, but not their own.
+	if status != models.TimesheetSubmitted {
+		currentUser, err := currentUserID(r)
+		if err != nil {
+			writeAccessError(w, err, "Failed to update timesheet period")
+			return
+		}
+		if currentUser == userID {
+			http.Error(w, "You cannot review your own timesheet", http.StatusForbidden)
+			return
+		}
+	}
+	authorize := authorizeReviewer
+	if status == models.TimesheetSubmitted {
+		authorize = authorizeUser
+	}
+	actorID, err := authorize(s.db, r, userID)
+	if err != nil {
+		writeAccessError(w, err, "Failed to update timesheet period")
+		return
+	}
+
+	var requestBody struct {
+		Comment string `json:"comment"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if status == models.TimesheetRejected && requestBody.Comment == "" {
+		http.Error(w, "A comment is required to reject a timesheet", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	weekStart, _, err := userWeek(tx, userID, day)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	week := weekStart.Format("2006-01-02")
+	_, err = tx.Exec("INSERT INTO timesheet_periods (user_id, week_start) VALUES ($1, $2) ON CONFLICT (user_id, week_start) DO NOTHING", userID, week)
+	if err != nil {
+		fmt.Printf("Error creating timesheet period: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	var current string
+	if err := tx.Get(&current, "SELECT status FROM timesheet_periods WHERE user_id = $1 AND week_start = $2 FOR UPDATE", userID, week); err != nil {
+		fmt.Printf("Error fetching timesheet period: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	allowed := false
+	for _, from := range timesheetTransitions[status] {
+		if current == from {
+			allowed = true
+		}
+	}
+	if !allowed {
+		http.Error(w, fmt.Sprintf("Cannot change a %s timesheet to %s", current, status), http.StatusConflict)
+		return
+	}
+
+	query := `UPDATE timesheet_periods SET status = $1, comment = $2, updated_at = NOW(),
+		submitted_at = CASE WHEN $1 = 'submitted' THEN NOW() ELSE submitted_at END,
+		reviewed_at = CASE WHEN $1 = 'submitted' THEN NULL ELSE NOW() END,
+		reviewer_id = CASE WHEN $1 = 'submitted' THEN NULL ELSE $3::INTEGER END
+		WHERE user_id = $4 AND week_start = $5 RETURNING id`
+	var periodID int
+	err = tx.Get(&periodID, query, status, requestBody.Comment, actorID, userID, week)
+	if err == nil {
+		_, err = tx.Exec("INSERT INTO timesheet_period_events (period_id, status, comment, user_id) VALUES ($1, $2, $3, $4)", periodID, status, requestBody.Comment, actorID)
+	}
+	if err != nil {
+		fmt.Printf("Error updating timesheet period: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	period, err := loadTimesheetPeriod(tx, userID, weekStart)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error updating timesheet period: %v\n", err)
+		http.Error(w, "Failed to update timesheet period", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(period)
+}
+
+// loadTimesheetPeriod returns the period of a user's week with its history.
+// Weeks that were never submitted are reported as open.
+func loadTimesheetPeriod(q sqlx.Queryer, userID int, weekStart time.Time) (models.TimesheetPeriod, error) {
+	var period models.TimesheetPeriod
+	err := sqlx.Get(q, &period, "SELECT "+timesheetPeriodColumns+" FROM timesheet_periods WHERE user_id = $1 AND week_start = $2", userID, weekStart.Format("2006-01-02"))
+	if err == sql.ErrNoRows {
+		return models.TimesheetPeriod{
+			UserID:    userID,
+			WeekStart: weekStart.Format("2006-01-02"),
+			Status:    models.TimesheetOpen,
+		}, nil
+	}
+	if err != nil {
+		return period, err
+	}
+
+	err = sqlx.Select(q, &period.Events, "SELECT id, period_id, status, comment, user_id, created_at FROM timesheet_period_events WHERE period_id = $1 ORDER BY created_at ASC, id ASC", period.ID)
+	return period, err
+}
+
+// weekApproved reports whether any of the given number of days starting on
+// the date of from falls in an approved timesheet week of the user. Weeks approved before the
+// user's week_start_day changed stay locked on the days they cover.
+func weekApproved(q sqlx.Queryer, userID int, from time.Time, days int) (bool, error) {
+	var approved bool
+	err := sqlx.Get(q, &approved, `SELECT EXISTS (SELECT 1 FROM timesheet_periods
+		WHERE user_id = $1 AND status = 'approved' AND week_start < $2::DATE + $3::INTEGER AND week_start + 7 > $2::DATE)`,
+		userID, from.Format("2006-01-02"), days)
+	return approved, err
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReviewersCannotReviewTheirOwnWeek(t *testing.T) {
+	t.Setenv("TRUST_USER_HEADER", "true")
+	s := &Server{}
+
+	for name, handler := range map[string]http.HandlerFunc{"approve": s.ApproveTimesheet, "reject": s.RejectTimesheet} {
+		for _, target := range []string{"", "&user_id=3"} {
+			req := httptest.NewRequest(http.MethodPost, "/api/timesheets/"+name+"?start=2026-03-02"+target, nil)
+			req.Header.Set("X-User-ID", "3")
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Errorf("%s with %q: got status %d, want 403", name, target, rec.Code)
+			}
+		}
+	}
+}
@@ -0,0 +1,477 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const timesheetDays = 7
+
+// timesheetEntry is a finished time entry as it counts towards a timesheet
+// cell, the day it starts on. Running entries without a duration are left out
+// of the grid.
+type timesheetEntry struct {
+	ID        int       `db:"id"`
+	ProjectID int       `db:"project_id"`
+	Day       string    `db:"day"`
+	StartTime time.Time `db:"start_time"`
+	EndTime   time.Time `db:"end_time"`
+	Duration  int       `db:"duration"`
+}
+
+// dayParts returns the parts of the entry on each calendar day in loc, with
+// Day, StartTime and Duration set for the part.
+func (e timesheetEntry) dayParts(loc *time.Location) []timesheetEntry {
+	entry := models.TimeEntry{StartTime: e.StartTime, EndTime: &e.EndTime, Duration: &e.Duration}
+	var parts []timesheetEntry
+	for _, part := range models.SplitAtMidnight(entry, loc) {
+		day := e
+		day.Day = part.StartTime.In(loc).Format("2006-01-02")
+		day.StartTime = part.StartTime
+		day.Duration = *part.Duration
+		parts = append(parts, day)
+	}
+	return parts
+}
+
+// allocateTimesheetEntries sums the time of the entries per cell. Entries
+// crossing midnight count towards every day they span.
+func allocateTimesheetEntries(entries []timesheetEntry, loc *time.Location) map[timesheetCell]int {
+	totals := make(map[timesheetCell]int)
+	for _, entry := range entries {
+		for _, part := range entry.dayParts(loc) {
+			totals[timesheetCell{part.ProjectID, part.Day}] += part.Duration
+		}
+	}
+	return totals
+}
+
+type timesheetCell struct {
+	projectID int
+	day       string
+}
+
+func (s *Server) GetWeeklyTimesheet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, day, err := timesheetWeek(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := authorizeUser(s.db, r, userID); err != nil {
+		writeAccessError(w, err, "Failed to fetch timesheet")
+		return
+	}
+
+	var timesheet models.Timesheet
+	weekStart, loc, err := userWeek(s.db, userID, day)
+	if err == nil {
+		timesheet, err = loadTimesheet(s.db, userID, weekStart, loc)
+	}
+	if err != nil {
+		fmt.Printf("Error fetching timesheet: %v\n", err)
+		http.Error(w, "Failed to fetch timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(timesheet)
+}
+
+// UpdateWeeklyTimesheet applies an edited timesheet grid. Every cell of the
+// submitted rows is brought to its new total by creating, shortening,
+// extending or deleting the underlying time entries; projects that are not
+// in the grid are left untouched. Entries crossing midnight into or out of a
+// changed cell are first split at midnight so each of their days can be
+// edited on its own.
+func (s *Server) UpdateWeeklyTimesheet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, day, err := timesheetWeek(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := authorizeUser(s.db, r, userID); err != nil {
+		writeAccessError(w, err, "Failed to update timesheet")
+		return
+	}
+
+	var timesheet models.Timesheet
+	if err := json.NewDecoder(r.Body).Decode(&timesheet); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	var projectIDs []int
+	seen := make(map[int]bool)
+	for _, row := range timesheet.Rows {
+		if seen[row.ProjectID] {
+			http.Error(w, fmt.Sprintf("Project %d appears more than once", row.ProjectID), http.StatusBadRequest)
+			return
+		}
+		seen[row.ProjectID] = true
+		if len(row.Hours) != timesheetDays {
+			http.Error(w, fmt.Sprintf("Project %d must have hours for %d days", row.ProjectID, timesheetDays), http.StatusBadRequest)
+			return
+		}
+		for _, hours := range row.Hours {
+			if hours < 0 || hours > 24 {
+				http.Error(w, "Hours must be between 0 and 24 per day", http.StatusBadRequest)
+				return
+			}
+		}
+		projectIDs = append(projectIDs, row.ProjectID)
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	weekStart, loc, err := userWeek(tx, userID, day)
+	if err != nil {
+		fmt.Printf("Error fetching settings: %v\n", err)
+		http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	approved, err := weekApproved(tx, userID, weekStart, timesheetDays)
+	if err != nil {
+		fmt.Printf("Error checking timesheet period: %v\n", err)
+		http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+		return
+	}
+	if approved {
+		http.Error(w, "Timesheet has been approved and can no longer be changed", http.StatusConflict)
+		return
+	}
+
+	var found int
+	if err := tx.Get(&found, "SELECT COUNT(DISTINCT id) FROM projects WHERE id = ANY($1)", pq.Array(projectIDs)); err != nil || found != len(projectIDs) {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	entries, err := loadTimesheetEntries(tx, userID, weekStart, loc)
+	if err != nil {
+		fmt.Printf("Error fetching timesheet entries: %v\n", err)
+		http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	allocated := allocateTimesheetEntries(entries, loc)
+	changed := make(map[timesheetCell]bool)
+	for _, row := range timesheet.Rows {
+		for i, hours := range row.Hours {
+			cell := timesheetCell{row.ProjectID, weekStart.AddDate(0, 0, i).Format("2006-01-02")}
+			if allocated[cell] != int(math.Round(hours*3600)) {
+				changed[cell] = true
+			}
+		}
+	}
+
+	split := false
+	for _, entry := range entries {
+		parts := entry.dayParts(loc)
+		if len(parts) == 1 {
+			continue
+		}
+		for _, part := range parts {
+			if !changed[timesheetCell{part.ProjectID, part.Day}] {
+				continue
+			}
+			if _, err := s.splitEntryAtMidnight(tx, r, entry.ID, loc); err != nil {
+				writeLockError(w, err, "Failed to update timesheet")
+				return
+			}
+			split = true
+			break
+		}
+	}
+
+	if split {
+		entries, err = loadTimesheetEntries(tx, userID, weekStart, loc)
+		if err != nil {
+			fmt.Printf("Error fetching timesheet entries: %v\n", err)
+			http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	cells := make(map[timesheetCell][]timesheetEntry)
+	for _, entry := range entries {
+		cell := timesheetCell{entry.ProjectID, entry.Day}
+		cells[cell] = append(cells[cell], entry)
+	}
+
+	for _, row := range timesheet.Rows {
+		settings, err := projectSettings(tx, row.ProjectID, userID)
+		if err != nil {
+			fmt.Printf("Error fetching settings: %v\n", err)
+			http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+			return
+		}
+
+		for i, hours := range row.Hours {
+			day := weekStart.AddDate(0, 0, i)
+			target := int(math.Round(hours * 3600))
+			cell := timesheetCell{row.ProjectID, day.Format("2006-01-02")}
+			if !changed[cell] {
+				continue
+			}
+			noon := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, loc)
+			if err := s.checkEntryLock(tx, r, userID, row.ProjectID, noon); err != nil {
+				writeLockError(w, err, "Failed to update timesheet")
+				return
+			}
+			if err := applyTimesheetCell(tx, userID, row.ProjectID, day, cells[cell], target, settings.DefaultBillable, loc); err != nil {
+				fmt.Printf("Error updating timesheet cell: %v\n", err)
+				http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	timesheet, err = loadTimesheet(tx, userID, weekStart, loc)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error updating timesheet: %v\n", err)
+		http.Error(w, "Failed to update timesheet", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(timesheet)
+}
+
+// timesheetWeek reads the user and the requested day of a timesheet request.
+// The user defaults to the requesting user. The day may be any day of the
+// week; userWeek finds the week it falls in.
+func timesheetWeek(r *http.Request) (int, time.Time, error) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if value := r.URL.Query().Get("user_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, time.Time{}, fmt.Errorf("Invalid user ID")
+		}
+		userID = id
+	}
+
+	start, err := time.Parse("2006-01-02", r.URL.Query().Get("start"))
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("Start date is required in YYYY-MM-DD format")
+	}
+
+	return userID, start, nil
+}
+
+// userWeek returns the start of the user's week that day falls in, following
+// the user's week_start_day, and the user's timezone.
+func userWeek(q sqlx.Queryer, userID int, day time.Time) (time.Time, *time.Location, error) {
+	settings, err := userPreferences(q, userID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return weekStartOf(day, time.Weekday(settings.WeekStartDay)), settings.Location(), nil
+}
+
+// weekStartOf returns the date of the first day of the week t falls in, for
+// weeks starting on first, as midnight UTC. Times are taken on the date they
+// read in their own location.
+func weekStartOf(t time.Time, first time.Weekday) time.Time {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(date.Weekday()) - int(first) + 7) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// loadTimesheetEntries returns the user's finished entries that fall on the
+// week, including those crossing midnight into or out of it, with their days
+// taken in loc. Entries without an end time end after their duration.
+func loadTimesheetEntries(q sqlx.Queryer, userID int, weekStart time.Time, loc *time.Location) ([]timesheetEntry, error) {
+	query := `SELECT id, project_id, TO_CHAR(DATE(start_time AT TIME ZONE $4), 'YYYY-MM-DD') AS day, start_time,
+			COALESCE(end_time, start_time + duration * INTERVAL '1 second') AS end_time, duration
+		FROM time_entries
+		WHERE user_id = $1 AND duration IS NOT NULL AND DATE(start_time AT TIME ZONE $4) < $3
+			AND COALESCE(end_time, start_time + duration * INTERVAL '1 second') > $2::DATE::TIMESTAMP AT TIME ZONE $4
+		ORDER BY start_time ASC, id ASC`
+
+	var entries []timesheetEntry
+	err := sqlx.Select(q, &entries, query, userID, weekStart.Format("2006-01-02"), weekStart.AddDate(0, 0, timesheetDays).Format("2006-01-02"), loc.String())
+	return entries, err
+}
+
+func loadTimesheet(q sqlx.Queryer, userID int, weekStart time.Time, loc *time.Location) (models.Timesheet, error) {
+	timesheet := models.Timesheet{
+		UserID:    userID,
+		WeekStart: weekStart.Format("2006-01-02"),
+		Rows:      []models.TimesheetRow{},
+		DayTotals: make([]float64, timesheetDays),
+	}
+
+	dayIndex := make(map[string]int, timesheetDays)
+	for i := 0; i < timesheetDays; i++ {
+		day := weekStart.AddDate(0, 0, i).Format("2006-01-02")
+		timesheet.Days = append(timesheet.Days, day)
+		dayIndex[day] = i
+	}
+
+	entries, err := loadTimesheetEntries(q, userID, weekStart, loc)
+	if err != nil {
+		return timesheet, err
+	}
+
+	var projectIDs []int
+	for _, entry := range entries {
+		projectIDs = append(projectIDs, entry.ProjectID)
+	}
+
+	var projects []models.Project
+	err = sqlx.Select(q, &projects, "SELECT "+projectColumns+" FROM projects WHERE id = ANY($1) ORDER BY name ASC", pq.Array(projectIDs))
+	if err != nil {
+		return timesheet, err
+	}
+
+	rowIndex := make(map[int]int, len(projects))
+	for i, project := range projects {
+		rowIndex[project.ID] = i
+		timesheet.Rows = append(timesheet.Rows, models.TimesheetRow{
+			ProjectID:   project.ID,
+			ProjectName: project.Name,
+			Hours:       make([]float64, timesheetDays),
+		})
+	}
+
+	for cell, seconds := range allocateTimesheetEntries(entries, loc) {
+		row, ok := rowIndex[cell.projectID]
+		day, inWeek := dayIndex[cell.day]
+		if !ok || !inWeek {
+			continue
+		}
+		hours := float64(seconds) / 3600
+		timesheet.Rows[row].Hours[day] += hours
+		timesheet.Rows[row].Total += hours
+		timesheet.DayTotals[day] += hours
+		timesheet.Total += hours
+	}
+
+	return timesheet, nil
+}
+
+// applyTimesheetCell brings the entries of one project and day to target
+// seconds. A new entry starting at 9:00 in loc is created for an empty cell,
+// billable as given;
+// otherwise the last entry of the day absorbs the difference. When that is
+// not enough to shrink the cell, the first entry is kept with the whole
+// target and the rest removed. Entries never grow past the end of the day;
+// see fitTimesheetEntry.
+func applyTimesheetCell(tx *sqlx.Tx, userID, projectID int, day time.Time, entries []timesheetEntry, target int, billable bool, loc *time.Location) error {
+	total := cellTotal(entries)
+	if total == target {
+		return nil
+	}
+
+	if target == 0 {
+		ids := make([]int, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.ID)
+		}
+		_, err := tx.Exec("DELETE FROM time_entries WHERE id = ANY($1)", pq.Array(ids))
+		return err
+	}
+
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	dayEnd := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+
+	if len(entries) == 0 {
+		start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, loc)
+		if latest := dayEnd.Add(-time.Duration(target) * time.Second); start.After(latest) {
+			start = latest
+		}
+		if start.Before(dayStart) {
+			start = dayStart
+		}
+		end := start.Add(time.Duration(target) * time.Second)
+		_, err := tx.Exec(`INSERT INTO time_entries (project_id, user_id, description, start_time, end_time, duration, billable) VALUES ($1, $2, '', $3, $4, $5, $6)`,
+			projectID, userID, start, end, target, billable)
+		return err
+	}
+
+	last := entries[len(entries)-1]
+	if duration := last.Duration + target - total; duration > 0 {
+		return fitTimesheetEntry(tx, last, duration, entries[0].StartTime, dayStart, dayEnd)
+	}
+
+	ids := make([]int, 0, len(entries)-1)
+	for _, entry := range entries[1:] {
+		ids = append(ids, entry.ID)
+	}
+	if _, err := tx.Exec("DELETE FROM time_entries WHERE id = ANY($1)", pq.Array(ids)); err != nil {
+		return err
+	}
+	return fitTimesheetEntry(tx, entries[0], target, entries[0].StartTime, dayStart, dayEnd)
+}
+
+// fitTimesheetEntry sets the duration of an entry without letting it run
+// past dayEnd. Time that does not fit goes into a copy of the entry ending at
+// before, or starting at dayStart if there is no room before it.
+func fitTimesheetEntry(tx *sqlx.Tx, entry timesheetEntry, duration int, before, dayStart, dayEnd time.Time) error {
+	room := int(dayEnd.Sub(entry.StartTime) / time.Second)
+	if duration <= room {
+		return setTimesheetEntryDuration(tx, entry, duration)
+	}
+	if err := setTimesheetEntryDuration(tx, entry, room); err != nil {
+		return err
+	}
+
+	remainder := duration - room
+	start := before.Add(-time.Duration(remainder) * time.Second)
+	if start.Before(dayStart) {
+		start = dayStart
+	}
+	end := start.Add(time.Duration(remainder) * time.Second)
+	_, err := tx.Exec(`INSERT INTO time_entries (project_id, user_id, description, start_time, end_time, duration, billable)
+		SELECT project_id, user_id, description, $1, $2, $3, billable FROM time_entries WHERE id = $4`,
+		start, end, remainder, entry.ID)
+	return err
+}
+
+func cellTotal(entries []timesheetEntry) int {
+	total := 0
+	for _, entry := range entries {
+		total += entry.Duration
+	}
+	return total
+}
+
+func setTimesheetEntryDuration(tx *sqlx.Tx, entry timesheetEntry, duration int) error {
+	end := entry.StartTime.Add(time.Duration(duration) * time.Second)
+	_, err := tx.Exec("UPDATE time_entries SET end_time = $1, duration = $2, updated_at = NOW() WHERE id = $3", end, duration, entry.ID)
+	return err
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStartOfFollowsWeekStartDay(t *testing.T) {
+	// Wednesday, 4 March 2026, late in the evening in Berlin.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2026, 3, 4, 23, 30, 0, 0, berlin)
+
+	want := map[time.Weekday]string{
+		time.Sunday:    "2026-03-01",
+		time.Monday:    "2026-03-02",
+		time.Wednesday: "2026-03-04",
+		time.Thursday:  "2026-02-26",
+	}
+	for first, start := range want {
+		if got := weekStartOf(day, first).Format("2006-01-02"); got != start {
+			t.Errorf("weeks starting on %s: got %s, want %s", first, got, start)
+		}
+	}
+}
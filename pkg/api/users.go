@@ -8,9 +8,18 @@ import (
 	"side-sync/pkg/models"
 )
 
+const userColumns = "id, email, name, created_at, updated_at"
+
 func (s *Server) GetUsers(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch users")
+		return
+	}
+
+	// Only the members of the caller's workspaces are listed.
 	var users []models.User
-	err := s.db.Select(&users, "SELECT id, email, name, created_at, updated_at FROM users ORDER BY created_at DESC")
+	err = s.db.Select(&users, "SELECT "+userColumns+" FROM users WHERE id IN (SELECT user_id FROM workspace_members WHERE "+memberWorkspacesClause("workspace_id", 1)+") ORDER BY created_at DESC", userID)
 	if err != nil {
 		fmt.Println(err)
 		http.Error(w, "Failed to fetch users", http.StatusInternalServerError)
@@ -0,0 +1,386 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"side-sync/pkg/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func (s *Server) GetWorkspaces(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to fetch workspaces")
+		return
+	}
+
+	workspaces := []models.Workspace{}
+	err = s.db.Select(&workspaces, `SELECT w.id, w.name, m.role, w.created_at, w.updated_at
+		FROM workspaces w JOIN workspace_members m ON m.workspace_id = w.id
+		WHERE m.user_id = $1 ORDER BY w.name ASC`, userID)
+	if err != nil {
+		fmt.Printf("Error fetching workspaces: %v\n", err)
+		http.Error(w, "Failed to fetch workspaces", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(workspaces)
+}
+
+// CreateWorkspace creates a workspace owned by the requesting user.
+func (s *Server) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		writeAccessError(w, err, "Failed to create workspace")
+		return
+	}
+
+	var workspace models.Workspace
+	if err := json.NewDecoder(r.Body).Decode(&workspace); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if workspace.Name == "" {
+		http.Error(w, "Workspace name is required", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to create workspace", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	workspace.Role = models.RoleOwner
+	err = tx.QueryRow(`INSERT INTO workspaces (name) VALUES ($1) RETURNING id, created_at, updated_at`, workspace.Name).Scan(&workspace.ID, &workspace.CreatedAt, &workspace.UpdatedAt)
+	if err == nil {
+		_, err = tx.Exec(`INSERT INTO workspace_members (workspace_id, user_id, role) VALUES ($1, $2, $3)`, workspace.ID, userID, workspace.Role)
+	}
+	if err == nil {
+		_, err = tx.Exec(`INSERT INTO settings (workspace_id) VALUES ($1)`, workspace.ID)
+	}
+	if err == nil {
+		_, err = tx.Exec(`INSERT INTO branding (workspace_id, primary_color, footer_text) VALUES ($1, '#3498db', 'Side Sync Time Tracking')`, workspace.ID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error creating workspace: %v\n", err)
+		http.Error(w, "Failed to create workspace", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(workspace)
+}
+
+func (s *Server) GetWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := strconv.Atoi(r.URL.Query().Get("workspace_id"))
+	if err != nil {
+		http.Error(w, "Workspace ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := authorizeWorkspace(s.db, r, workspaceID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch workspace members")
+		return
+	}
+
+	members := []models.WorkspaceMember{}
+	err = s.db.Select(&members, `SELECT m.workspace_id, m.user_id, m.role, u.name, u.email, m.created_at
+		FROM workspace_members m JOIN users u ON u.id = m.user_id
+		WHERE m.workspace_id = $1 ORDER BY u.name ASC`, workspaceID)
+	if err != nil {
+		fmt.Printf("Error fetching workspace members: %v\n", err)
+		http.Error(w, "Failed to fetch workspace members", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(members)
+}
+
+// AddWorkspaceMember adds a user to a workspace or changes their role. Admins
+// manage members, admins and viewers; only owners grant or take away the
+// owner role.
+func (s *Server) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	workspaceID, err := strconv.Atoi(r.URL.Query().Get("workspace_id"))
+	if err != nil {
+		http.Error(w, "Workspace ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var member models.WorkspaceMember
+	if err := json.NewDecoder(r.Body).Decode(&member); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if member.Role == "" {
+		member.Role = models.RoleMember
+	}
+	if !models.IsSupportedRole(member.Role) {
+		http.Error(w, "Invalid role, use owner, admin, member or viewer", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to update workspace member", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	if err := authorizeMemberChange(tx, r, workspaceID, member.UserID, member.Role); err != nil {
+		writeAccessError(w, err, "Failed to update workspace member")
+		return
+	}
+
+	member.WorkspaceID = workspaceID
+	query := `INSERT INTO workspace_members (workspace_id, user_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (workspace_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		RETURNING created_at`
+	err = tx.QueryRow(query, workspaceID, member.UserID, member.Role).Scan(&member.CreatedAt)
+	if err == nil {
+		err = tx.QueryRow("SELECT name, email FROM users WHERE id = $1", member.UserID).Scan(&member.Name, &member.Email)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error updating workspace member: %v\n", err)
+		http.Error(w, "Failed to update workspace member", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(member)
+}
+
+func (s *Server) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	workspaceID, err := strconv.Atoi(r.URL.Query().Get("workspace_id"))
+	if err != nil {
+		http.Error(w, "Workspace ID is required", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := strconv.Atoi(r.URL.Query().Get("user_id"))
+	if err != nil {
+		http.Error(w, "User ID is required", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		fmt.Printf("Error starting transaction: %v\n", err)
+		http.Error(w, "Failed to remove workspace member", http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	if err := authorizeMemberChange(tx, r, workspaceID, userID, ""); err != nil {
+		writeAccessError(w, err, "Failed to remove workspace member")
+		return
+	}
+
+	_, err = tx.Exec(`DELETE FROM project_members WHERE user_id = $1 AND project_id IN (SELECT id FROM projects WHERE workspace_id = $2)`, userID, workspaceID)
+	if err == nil {
+		_, err = tx.Exec(`DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`, workspaceID, userID)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		fmt.Printf("Error removing workspace member: %v\n", err)
+		http.Error(w, "Failed to remove workspace member", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Workspace member removed",
+	})
+}
+
+// authorizeMemberChange checks that the requesting user may give userID the
+// role in the workspace, or remove them when role is empty. Owner roles are
+// managed by owners only, and the last owner cannot be demoted or removed.
+func authorizeMemberChange(q sqlx.Queryer, r *http.Request, workspaceID, userID int, role string) error {
+	actorID, err := authorizeWorkspace(q, r, workspaceID, models.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	if err := sqlx.Get(q, &exists, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", userID); err != nil {
+		return err
+	}
+	if !exists {
+		return &accessError{http.StatusNotFound, "User not found"}
+	}
+
+	actorRole, err := workspaceRole(q, actorID, workspaceID)
+	if err != nil {
+		return err
+	}
+	currentRole, err := workspaceRole(q, userID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if (role == models.RoleOwner || currentRole == models.RoleOwner) && actorRole != models.RoleOwner {
+		return &accessError{http.StatusForbidden, "Only owners can manage owners"}
+	}
+
+	if currentRole == models.RoleOwner && role != models.RoleOwner {
+		var owners int
+		if err := sqlx.Get(q, &owners, "SELECT COUNT(*) FROM workspace_members WHERE workspace_id = $1 AND role = 'owner'", workspaceID); err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return &accessError{http.StatusConflict, "A workspace needs at least one owner"}
+		}
+	}
+
+	if role == "" && currentRole == "" {
+		return &accessError{http.StatusNotFound, "Workspace member not found"}
+	}
+	return nil
+}
+
+func (s *Server) GetProjectMembers(w http.ResponseWriter, r *http.Request) {
+	projectID := r.URL.Query().Get("project_id")
+	if projectID == "" {
+		http.Error(w, "Project ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleViewer); err != nil {
+		writeAccessError(w, err, "Failed to fetch project members")
+		return
+	}
+
+	members := []models.ProjectMember{}
+	err := s.db.Select(&members, `SELECT m.project_id, m.user_id, u.name, u.email, m.created_at
+		FROM project_members m JOIN users u ON u.id = m.user_id
+		WHERE m.project_id = $1 ORDER BY u.name ASC`, projectID)
+	if err != nil {
+		fmt.Printf("Error fetching project members: %v\n", err)
+		http.Error(w, "Failed to fetch project members", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(members)
+}
+
+// AddProjectMember assigns a member of the project's workspace to the
+// project so they can log time on it.
+func (s *Server) AddProjectMember(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	projectID, err := strconv.Atoi(r.URL.Query().Get("project_id"))
+	if err != nil {
+		http.Error(w, "Project ID is required", http.StatusBadRequest)
+		return
+	}
+
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to add project member")
+		return
+	}
+
+	var member models.ProjectMember
+	if err := json.NewDecoder(r.Body).Decode(&member); err != nil {
+		http.Error(w, "Invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	member.ProjectID = projectID
+	query := `INSERT INTO project_members (project_id, user_id)
+		SELECT p.id, m.user_id FROM projects p
+		JOIN workspace_members m ON m.workspace_id = p.workspace_id
+		WHERE p.id = $1 AND m.user_id = $2
+		ON CONFLICT (project_id, user_id) DO UPDATE SET project_id = EXCLUDED.project_id
+		RETURNING created_at`
+	err = s.db.QueryRow(query, projectID, member.UserID).Scan(&member.CreatedAt)
+	if err == sql.ErrNoRows {
+		http.Error(w, "User is not a member of the project's workspace", http.StatusBadRequest)
+		return
+	}
+	if err == nil {
+		err = s.db.QueryRow("SELECT name, email FROM users WHERE id = $1", member.UserID).Scan(&member.Name, &member.Email)
+	}
+	if err != nil {
+		fmt.Printf("Error adding project member: %v\n", err)
+		http.Error(w, "Failed to add project member", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(member)
+}
+
+func (s *Server) RemoveProjectMember(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	projectID := r.URL.Query().Get("project_id")
+	userID := r.URL.Query().Get("user_id")
+	if projectID == "" || userID == "" {
+		http.Error(w, "Project ID and user ID are required", http.StatusBadRequest)
+		return
+	}
+
+	if _, _, err := authorizeProject(s.db, r, projectID, models.RoleAdmin); err != nil {
+		writeAccessError(w, err, "Failed to remove project member")
+		return
+	}
+
+	_, err := s.db.Exec(`DELETE FROM project_members WHERE project_id = $1 AND user_id = $2`, projectID, userID)
+	if err != nil {
+		fmt.Printf("Error removing project member: %v\n", err)
+		http.Error(w, "Failed to remove project member", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Project member removed",
+	})
+}
@@ -0,0 +1,19812 @@
+// Package apiclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	UserIDScopes = "userID.Scopes"
+)
+
+// Defines values for TimesheetStatus.
+const (
+	Approved  TimesheetStatus = "approved"
+	Open      TimesheetStatus = "open"
+	Rejected  TimesheetStatus = "rejected"
+	Submitted TimesheetStatus = "submitted"
+)
+
+// Defines values for BillableFilter.
+const (
+	BillableFilterBillable    BillableFilter = "billable"
+	BillableFilterNonBillable BillableFilter = "non-billable"
+)
+
+// Defines values for LegacyExportReportParamsFormat.
+const (
+	LegacyExportReportParamsFormatCsv  LegacyExportReportParamsFormat = "csv"
+	LegacyExportReportParamsFormatXlsx LegacyExportReportParamsFormat = "xlsx"
+)
+
+// Defines values for LegacyExportReportParamsBillable.
+const (
+	LegacyExportReportParamsBillableBillable    LegacyExportReportParamsBillable = "billable"
+	LegacyExportReportParamsBillableNonBillable LegacyExportReportParamsBillable = "non-billable"
+)
+
+// Defines values for LegacyGetPDFReportParamsBillable.
+const (
+	LegacyGetPDFReportParamsBillableBillable    LegacyGetPDFReportParamsBillable = "billable"
+	LegacyGetPDFReportParamsBillableNonBillable LegacyGetPDFReportParamsBillable = "non-billable"
+)
+
+// Defines values for LegacyGetPDFReportParamsGroupBy.
+const (
+	LegacyGetPDFReportParamsGroupByDay  LegacyGetPDFReportParamsGroupBy = "day"
+	LegacyGetPDFReportParamsGroupByTag  LegacyGetPDFReportParamsGroupBy = "tag"
+	LegacyGetPDFReportParamsGroupByTask LegacyGetPDFReportParamsGroupBy = "task"
+	LegacyGetPDFReportParamsGroupByWeek LegacyGetPDFReportParamsGroupBy = "week"
+)
+
+// Defines values for LegacyGetPDFReportParamsLocale.
+const (
+	LegacyGetPDFReportParamsLocaleDe LegacyGetPDFReportParamsLocale = "de"
+	LegacyGetPDFReportParamsLocaleEn LegacyGetPDFReportParamsLocale = "en"
+)
+
+// Defines values for LegacyGetPDFReportParamsColumns.
+const (
+	LegacyGetPDFReportParamsColumnsBillable    LegacyGetPDFReportParamsColumns = "billable"
+	LegacyGetPDFReportParamsColumnsCost        LegacyGetPDFReportParamsColumns = "cost"
+	LegacyGetPDFReportParamsColumnsDate        LegacyGetPDFReportParamsColumns = "date"
+	LegacyGetPDFReportParamsColumnsDescription LegacyGetPDFReportParamsColumns = "description"
+	LegacyGetPDFReportParamsColumnsDuration    LegacyGetPDFReportParamsColumns = "duration"
+	LegacyGetPDFReportParamsColumnsEnd         LegacyGetPDFReportParamsColumns = "end"
+	LegacyGetPDFReportParamsColumnsHours       LegacyGetPDFReportParamsColumns = "hours"
+	LegacyGetPDFReportParamsColumnsRate        LegacyGetPDFReportParamsColumns = "rate"
+	LegacyGetPDFReportParamsColumnsStart       LegacyGetPDFReportParamsColumns = "start"
+	LegacyGetPDFReportParamsColumnsTags        LegacyGetPDFReportParamsColumns = "tags"
+)
+
+// Defines values for LegacyGetPDFReportParamsRoundMode.
+const (
+	LegacyGetPDFReportParamsRoundModeDown    LegacyGetPDFReportParamsRoundMode = "down"
+	LegacyGetPDFReportParamsRoundModeNearest LegacyGetPDFReportParamsRoundMode = "nearest"
+	LegacyGetPDFReportParamsRoundModeNone    LegacyGetPDFReportParamsRoundMode = "none"
+	LegacyGetPDFReportParamsRoundModeUp      LegacyGetPDFReportParamsRoundMode = "up"
+)
+
+// Defines values for LegacyGetPDFReportParamsRoundScope.
+const (
+	LegacyGetPDFReportParamsRoundScopeDay   LegacyGetPDFReportParamsRoundScope = "day"
+	LegacyGetPDFReportParamsRoundScopeEntry LegacyGetPDFReportParamsRoundScope = "entry"
+)
+
+// Defines values for LegacyGetReportSummaryParamsGroupBy.
+const (
+	LegacyGetReportSummaryParamsGroupByBillable LegacyGetReportSummaryParamsGroupBy = "billable"
+	LegacyGetReportSummaryParamsGroupByClient   LegacyGetReportSummaryParamsGroupBy = "client"
+	LegacyGetReportSummaryParamsGroupByDay      LegacyGetReportSummaryParamsGroupBy = "day"
+	LegacyGetReportSummaryParamsGroupByMonth    LegacyGetReportSummaryParamsGroupBy = "month"
+	LegacyGetReportSummaryParamsGroupByProject  LegacyGetReportSummaryParamsGroupBy = "project"
+	LegacyGetReportSummaryParamsGroupByTag      LegacyGetReportSummaryParamsGroupBy = "tag"
+	LegacyGetReportSummaryParamsGroupByWeek     LegacyGetReportSummaryParamsGroupBy = "week"
+)
+
+// Defines values for LegacyGetReportSummaryParamsBillable.
+const (
+	LegacyGetReportSummaryParamsBillableBillable    LegacyGetReportSummaryParamsBillable = "billable"
+	LegacyGetReportSummaryParamsBillableNonBillable LegacyGetReportSummaryParamsBillable = "non-billable"
+)
+
+// Defines values for LegacyGetSettingsParamsScope.
+const (
+	LegacyGetSettingsParamsScopeWorkspace LegacyGetSettingsParamsScope = "workspace"
+)
+
+// Defines values for LegacyListProjectTimeEntriesParamsBillable.
+const (
+	LegacyListProjectTimeEntriesParamsBillableBillable    LegacyListProjectTimeEntriesParamsBillable = "billable"
+	LegacyListProjectTimeEntriesParamsBillableNonBillable LegacyListProjectTimeEntriesParamsBillable = "non-billable"
+)
+
+// Defines values for ListProjectTimeEntriesParamsBillable.
+const (
+	ListProjectTimeEntriesParamsBillableBillable    ListProjectTimeEntriesParamsBillable = "billable"
+	ListProjectTimeEntriesParamsBillableNonBillable ListProjectTimeEntriesParamsBillable = "non-billable"
+)
+
+// Defines values for ExportReportParamsFormat.
+const (
+	ExportReportParamsFormatCsv  ExportReportParamsFormat = "csv"
+	ExportReportParamsFormatXlsx ExportReportParamsFormat = "xlsx"
+)
+
+// Defines values for ExportReportParamsBillable.
+const (
+	ExportReportParamsBillableBillable    ExportReportParamsBillable = "billable"
+	ExportReportParamsBillableNonBillable ExportReportParamsBillable = "non-billable"
+)
+
+// Defines values for GetPDFReportParamsBillable.
+const (
+	GetPDFReportParamsBillableBillable    GetPDFReportParamsBillable = "billable"
+	GetPDFReportParamsBillableNonBillable GetPDFReportParamsBillable = "non-billable"
+)
+
+// Defines values for GetPDFReportParamsGroupBy.
+const (
+	GetPDFReportParamsGroupByDay  GetPDFReportParamsGroupBy = "day"
+	GetPDFReportParamsGroupByTag  GetPDFReportParamsGroupBy = "tag"
+	GetPDFReportParamsGroupByTask GetPDFReportParamsGroupBy = "task"
+	GetPDFReportParamsGroupByWeek GetPDFReportParamsGroupBy = "week"
+)
+
+// Defines values for GetPDFReportParamsLocale.
+const (
+	GetPDFReportParamsLocaleDe GetPDFReportParamsLocale = "de"
+	GetPDFReportParamsLocaleEn GetPDFReportParamsLocale = "en"
+)
+
+// Defines values for GetPDFReportParamsColumns.
+const (
+	GetPDFReportParamsColumnsBillable    GetPDFReportParamsColumns = "billable"
+	GetPDFReportParamsColumnsCost        GetPDFReportParamsColumns = "cost"
+	GetPDFReportParamsColumnsDate        GetPDFReportParamsColumns = "date"
+	GetPDFReportParamsColumnsDescription GetPDFReportParamsColumns = "description"
+	GetPDFReportParamsColumnsDuration    GetPDFReportParamsColumns = "duration"
+	GetPDFReportParamsColumnsEnd         GetPDFReportParamsColumns = "end"
+	GetPDFReportParamsColumnsHours       GetPDFReportParamsColumns = "hours"
+	GetPDFReportParamsColumnsRate        GetPDFReportParamsColumns = "rate"
+	GetPDFReportParamsColumnsStart       GetPDFReportParamsColumns = "start"
+	GetPDFReportParamsColumnsTags        GetPDFReportParamsColumns = "tags"
+)
+
+// Defines values for GetPDFReportParamsRoundMode.
+const (
+	GetPDFReportParamsRoundModeDown    GetPDFReportParamsRoundMode = "down"
+	GetPDFReportParamsRoundModeNearest GetPDFReportParamsRoundMode = "nearest"
+	GetPDFReportParamsRoundModeNone    GetPDFReportParamsRoundMode = "none"
+	GetPDFReportParamsRoundModeUp      GetPDFReportParamsRoundMode = "up"
+)
+
+// Defines values for GetPDFReportParamsRoundScope.
+const (
+	GetPDFReportParamsRoundScopeDay   GetPDFReportParamsRoundScope = "day"
+	GetPDFReportParamsRoundScopeEntry GetPDFReportParamsRoundScope = "entry"
+)
+
+// Defines values for GetReportSummaryParamsGroupBy.
+const (
+	GetReportSummaryParamsGroupByBillable GetReportSummaryParamsGroupBy = "billable"
+	GetReportSummaryParamsGroupByClient   GetReportSummaryParamsGroupBy = "client"
+	GetReportSummaryParamsGroupByDay      GetReportSummaryParamsGroupBy = "day"
+	GetReportSummaryParamsGroupByMonth    GetReportSummaryParamsGroupBy = "month"
+	GetReportSummaryParamsGroupByProject  GetReportSummaryParamsGroupBy = "project"
+	GetReportSummaryParamsGroupByTag      GetReportSummaryParamsGroupBy = "tag"
+	GetReportSummaryParamsGroupByWeek     GetReportSummaryParamsGroupBy = "week"
+)
+
+// Defines values for GetReportSummaryParamsBillable.
+const (
+	Billable    GetReportSummaryParamsBillable = "billable"
+	NonBillable GetReportSummaryParamsBillable = "non-billable"
+)
+
+// Defines values for GetSettingsParamsScope.
+const (
+	GetSettingsParamsScopeWorkspace GetSettingsParamsScope = "workspace"
+)
+
+// BillableResult defines model for BillableResult.
+type BillableResult struct {
+	Billable *bool `json:"billable,omitempty"`
+	Success  *bool `json:"success,omitempty"`
+}
+
+// BillableUpdate defines model for BillableUpdate.
+type BillableUpdate struct {
+	Billable bool `json:"billable"`
+}
+
+// Branding defines model for Branding.
+type Branding = models.Branding
+
+// BulkFilter Matches entries in the requesting user's workspaces; dates are in the user's timezone
+type BulkFilter struct {
+	Billable  *bool               `json:"billable,omitempty"`
+	ClientId  *int                `json:"client_id,omitempty"`
+	DateFrom  *openapi_types.Date `json:"date_from,omitempty"`
+	DateTo    *openapi_types.Date `json:"date_to,omitempty"`
+	ProjectId *int                `json:"project_id,omitempty"`
+	UserId    *int                `json:"user_id,omitempty"`
+}
+
+// BulkResponse defines model for BulkResponse.
+type BulkResponse struct {
+	Failed    *int          `json:"failed,omitempty"`
+	Processed *int          `json:"processed,omitempty"`
+	Results   *[]BulkResult `json:"results,omitempty"`
+
+	// Success True when no entry failed
+	Success *bool `json:"success,omitempty"`
+}
+
+// BulkResult defines model for BulkResult.
+type BulkResult = models.BulkResult
+
+// BulkSelection Either ids or filter, selecting at most 1000 entries
+type BulkSelection struct {
+	// Filter Matches entries in the requesting user's workspaces; dates are in the user's timezone
+	Filter *BulkFilter `json:"filter,omitempty"`
+	Ids    *[]int      `json:"ids,omitempty"`
+}
+
+// BulkUpdate Fields to change; omitted fields are kept
+type BulkUpdate struct {
+	Billable    *bool   `json:"billable,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ProjectId   *int    `json:"project_id,omitempty"`
+
+	// ShiftMinutes Moves start and end time by this many minutes
+	ShiftMinutes *int      `json:"shift_minutes,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+}
+
+// BulkUpdateRequest defines model for BulkUpdateRequest.
+type BulkUpdateRequest struct {
+	// Filter Matches entries in the requesting user's workspaces; dates are in the user's timezone
+	Filter *BulkFilter `json:"filter,omitempty"`
+	Ids    *[]int      `json:"ids,omitempty"`
+
+	// Update Fields to change; omitted fields are kept
+	Update BulkUpdate `json:"update"`
+}
+
+// Client defines model for Client.
+type Client = models.Client
+
+// Currency defines model for Currency.
+type Currency struct {
+	Code   *string `json:"code,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Symbol *string `json:"symbol,omitempty"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Service *string `json:"service,omitempty"`
+	Status  *string `json:"status,omitempty"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	ImportedCount *int    `json:"imported_count,omitempty"`
+	Message       *string `json:"message,omitempty"`
+
+	// RejectedRows CSV rows that could not be read and were skipped
+	RejectedRows *[]struct {
+		Error string `json:"error"`
+
+		// Row Line number in the file, counting the header as line 1
+		Row int `json:"row"`
+	} `json:"rejected_rows,omitempty"`
+	Success *bool `json:"success,omitempty"`
+}
+
+// LogoUploadResult defines model for LogoUploadResult.
+type LogoUploadResult struct {
+	ContentType *string `json:"content_type,omitempty"`
+	Size        *int    `json:"size,omitempty"`
+	Success     *bool   `json:"success,omitempty"`
+}
+
+// MergePatch JSON merge patch (RFC 7386): omitted fields are kept and null clears a field
+type MergePatch map[string]interface{}
+
+// MergeRequest defines model for MergeRequest.
+type MergeRequest struct {
+	// Description Replaces the joined descriptions of the merged entries
+	Description *string `json:"description,omitempty"`
+	Ids         []int   `json:"ids"`
+}
+
+// Project defines model for Project.
+type Project = models.Project
+
+// ProjectMember defines model for ProjectMember.
+type ProjectMember = models.ProjectMember
+
+// Settings defines model for Settings.
+type Settings = models.Settings
+
+// SplitRequest defines model for SplitRequest.
+type SplitRequest struct {
+	// At Split at this point in time
+	At *time.Time `json:"at,omitempty"`
+	Id int        `json:"id"`
+
+	// MovePart Index of the part, counted from 0, to move to project_id
+	MovePart *int `json:"move_part,omitempty"`
+
+	// Parts Split into this many equal parts instead
+	Parts     *int `json:"parts,omitempty"`
+	ProjectId *int `json:"project_id,omitempty"`
+}
+
+// SuccessMessage defines model for SuccessMessage.
+type SuccessMessage struct {
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// Summary defines model for Summary.
+type Summary = models.Summary
+
+// SummaryGroup Totals of one group; only the fields of the requested dimensions are set
+type SummaryGroup = models.SummaryGroup
+
+// SummaryTotals defines model for SummaryTotals.
+type SummaryTotals = models.SummaryTotals
+
+// Tag defines model for Tag.
+type Tag = models.Tag
+
+// TagsResult defines model for TagsResult.
+type TagsResult struct {
+	Success *bool     `json:"success,omitempty"`
+	Tags    *[]string `json:"tags,omitempty"`
+}
+
+// TagsUpdate defines model for TagsUpdate.
+type TagsUpdate struct {
+	Tags []string `json:"tags"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry = models.TimeEntry
+
+// TimeEntryHistory defines model for TimeEntryHistory.
+type TimeEntryHistory = models.TimeEntryHistory
+
+// Timesheet defines model for Timesheet.
+type Timesheet = models.Timesheet
+
+// TimesheetComment defines model for TimesheetComment.
+type TimesheetComment struct {
+	// Comment Required when rejecting
+	Comment *string `json:"comment,omitempty"`
+}
+
+// TimesheetEvent defines model for TimesheetEvent.
+type TimesheetEvent = models.TimesheetEvent
+
+// TimesheetPeriod defines model for TimesheetPeriod.
+type TimesheetPeriod = models.TimesheetPeriod
+
+// TimesheetRow defines model for TimesheetRow.
+type TimesheetRow = models.TimesheetRow
+
+// TimesheetStatus defines model for TimesheetStatus.
+type TimesheetStatus string
+
+// User defines model for User.
+type User = models.User
+
+// UserSettings Overrides of the workspace's display and time keeping preferences for one user; null fields use the workspace value. Rates and currency are not overridden per user
+type UserSettings = models.UserSettings
+
+// Workspace defines model for Workspace.
+type Workspace = models.Workspace
+
+// WorkspaceMember defines model for WorkspaceMember.
+type WorkspaceMember = models.WorkspaceMember
+
+// BillableFilter defines model for BillableFilter.
+type BillableFilter string
+
+// ClientID defines model for ClientID.
+type ClientID = int
+
+// ClientIDQuery defines model for ClientIDQuery.
+type ClientIDQuery = int
+
+// DateFrom defines model for DateFrom.
+type DateFrom = openapi_types.Date
+
+// DateTo defines model for DateTo.
+type DateTo = openapi_types.Date
+
+// Effective defines model for Effective.
+type Effective = bool
+
+// ID defines model for ID.
+type ID = int
+
+// IDQuery defines model for IDQuery.
+type IDQuery = int
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IncludePricing defines model for IncludePricing.
+type IncludePricing = bool
+
+// MemberUserID defines model for MemberUserID.
+type MemberUserID = int
+
+// MemberUserIDQuery defines model for MemberUserIDQuery.
+type MemberUserIDQuery = int
+
+// OverrideLock defines model for OverrideLock.
+type OverrideLock = bool
+
+// ProjectID defines model for ProjectID.
+type ProjectID = int
+
+// ProjectIDQuery defines model for ProjectIDQuery.
+type ProjectIDQuery = int
+
+// TimesheetUser defines model for TimesheetUser.
+type TimesheetUser = int
+
+// Week defines model for Week.
+type Week = openapi_types.Date
+
+// WorkspaceID defines model for WorkspaceID.
+type WorkspaceID = int
+
+// WorkspaceIDQuery defines model for WorkspaceIDQuery.
+type WorkspaceIDQuery = int
+
+// LegacyGetBrandingParams defines parameters for LegacyGetBranding.
+type LegacyGetBrandingParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// Effective Merge the client's branding with the default
+	Effective *Effective `form:"effective,omitempty" json:"effective,omitempty"`
+}
+
+// LegacyUpdateBrandingParams defines parameters for LegacyUpdateBranding.
+type LegacyUpdateBrandingParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// LegacyDeleteBrandingLogoParams defines parameters for LegacyDeleteBrandingLogo.
+type LegacyDeleteBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// LegacyGetBrandingLogoParams defines parameters for LegacyGetBrandingLogo.
+type LegacyGetBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// LegacyUploadBrandingLogoMultipartBody defines parameters for LegacyUploadBrandingLogo.
+type LegacyUploadBrandingLogoMultipartBody struct {
+	// Logo PNG or JPEG
+	Logo openapi_types.File `json:"logo"`
+}
+
+// LegacyUploadBrandingLogoParams defines parameters for LegacyUploadBrandingLogo.
+type LegacyUploadBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// LegacyDeleteClientParams defines parameters for LegacyDeleteClient.
+type LegacyDeleteClientParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyGetClientParams defines parameters for LegacyGetClient.
+type LegacyGetClientParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyUpdateClientParams defines parameters for LegacyUpdateClient.
+type LegacyUpdateClientParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyCreateProjectParams defines parameters for LegacyCreateProject.
+type LegacyCreateProjectParams struct {
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// LegacyRemoveProjectMemberParams defines parameters for LegacyRemoveProjectMember.
+type LegacyRemoveProjectMemberParams struct {
+	ProjectId ProjectIDQuery    `form:"project_id" json:"project_id"`
+	UserId    MemberUserIDQuery `form:"user_id" json:"user_id"`
+}
+
+// LegacyListProjectMembersParams defines parameters for LegacyListProjectMembers.
+type LegacyListProjectMembersParams struct {
+	ProjectId ProjectIDQuery `form:"project_id" json:"project_id"`
+}
+
+// LegacyAddProjectMemberParams defines parameters for LegacyAddProjectMember.
+type LegacyAddProjectMemberParams struct {
+	ProjectId ProjectIDQuery `form:"project_id" json:"project_id"`
+}
+
+// LegacyDeleteProjectParams defines parameters for LegacyDeleteProject.
+type LegacyDeleteProjectParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyGetProjectParams defines parameters for LegacyGetProject.
+type LegacyGetProjectParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyPatchProjectParams defines parameters for LegacyPatchProject.
+type LegacyPatchProjectParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyReplaceProjectParams defines parameters for LegacyReplaceProject.
+type LegacyReplaceProjectParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyExportReportParams defines parameters for LegacyExportReport.
+type LegacyExportReportParams struct {
+	Format *LegacyExportReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// ProjectId Defaults to all projects
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                           `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *LegacyExportReportParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// IncludePricing Set to false to leave out rates and amounts
+	IncludePricing *IncludePricing `form:"include_pricing,omitempty" json:"include_pricing,omitempty"`
+}
+
+// LegacyExportReportParamsFormat defines parameters for LegacyExportReport.
+type LegacyExportReportParamsFormat string
+
+// LegacyExportReportParamsBillable defines parameters for LegacyExportReport.
+type LegacyExportReportParamsBillable string
+
+// LegacyGetPDFReportParams defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParams struct {
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// ProjectIds Report on several projects with per-project subtotals
+	ProjectIds *[]int `form:"project_ids,omitempty" json:"project_ids,omitempty"`
+
+	// ClientId Report on all projects of a client
+	ClientId *int `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                           `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *LegacyGetPDFReportParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// IncludePricing Set to false to leave out rates and amounts
+	IncludePricing *IncludePricing                  `form:"include_pricing,omitempty" json:"include_pricing,omitempty"`
+	GroupBy        *LegacyGetPDFReportParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Collapse Show only the group subtotals
+	Collapse *bool `form:"collapse,omitempty" json:"collapse,omitempty"`
+
+	// Locale Defaults to the client's locale
+	Locale  *LegacyGetPDFReportParamsLocale    `form:"locale,omitempty" json:"locale,omitempty"`
+	Columns *[]LegacyGetPDFReportParamsColumns `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// RoundMinutes Round with this increment instead of the configured rounding
+	RoundMinutes *int                                `form:"round_minutes,omitempty" json:"round_minutes,omitempty"`
+	RoundMode    *LegacyGetPDFReportParamsRoundMode  `form:"round_mode,omitempty" json:"round_mode,omitempty"`
+	RoundScope   *LegacyGetPDFReportParamsRoundScope `form:"round_scope,omitempty" json:"round_scope,omitempty"`
+}
+
+// LegacyGetPDFReportParamsBillable defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsBillable string
+
+// LegacyGetPDFReportParamsGroupBy defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsGroupBy string
+
+// LegacyGetPDFReportParamsLocale defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsLocale string
+
+// LegacyGetPDFReportParamsColumns defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsColumns string
+
+// LegacyGetPDFReportParamsRoundMode defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsRoundMode string
+
+// LegacyGetPDFReportParamsRoundScope defines parameters for LegacyGetPDFReport.
+type LegacyGetPDFReportParamsRoundScope string
+
+// LegacyGetReportSummaryParams defines parameters for LegacyGetReportSummary.
+type LegacyGetReportSummaryParams struct {
+	// GroupBy At most one of day, week and month
+	GroupBy *[]LegacyGetReportSummaryParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo    *DateTo                               `form:"date_to,omitempty" json:"date_to,omitempty"`
+	ProjectId *int                                  `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ClientId  *int                                  `form:"client_id,omitempty" json:"client_id,omitempty"`
+	Billable  *LegacyGetReportSummaryParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// LegacyGetReportSummaryParamsGroupBy defines parameters for LegacyGetReportSummary.
+type LegacyGetReportSummaryParamsGroupBy string
+
+// LegacyGetReportSummaryParamsBillable defines parameters for LegacyGetReportSummary.
+type LegacyGetReportSummaryParamsBillable string
+
+// LegacyGetSettingsParams defines parameters for LegacyGetSettings.
+type LegacyGetSettingsParams struct {
+	// Scope Set to workspace for the workspace's own settings
+	Scope *LegacyGetSettingsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// LegacyGetSettingsParamsScope defines parameters for LegacyGetSettings.
+type LegacyGetSettingsParamsScope string
+
+// LegacyPatchSettingsParams defines parameters for LegacyPatchSettings.
+type LegacyPatchSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyReplaceSettingsParams defines parameters for LegacyReplaceSettings.
+type LegacyReplaceSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyPatchUserSettingsParams defines parameters for LegacyPatchUserSettings.
+type LegacyPatchUserSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyReplaceUserSettingsParams defines parameters for LegacyReplaceUserSettings.
+type LegacyReplaceUserSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyCreateTimeEntryParams defines parameters for LegacyCreateTimeEntry.
+type LegacyCreateTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// LegacySetTimeEntryBillableParams defines parameters for LegacySetTimeEntryBillable.
+type LegacySetTimeEntryBillableParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyBulkDeleteTimeEntriesParams defines parameters for LegacyBulkDeleteTimeEntries.
+type LegacyBulkDeleteTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// LegacyBulkUpdateTimeEntriesParams defines parameters for LegacyBulkUpdateTimeEntries.
+type LegacyBulkUpdateTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// LegacyGetTimeEntryHistoryParams defines parameters for LegacyGetTimeEntryHistory.
+type LegacyGetTimeEntryHistoryParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyImportTimeEntriesMultipartBody defines parameters for LegacyImportTimeEntries.
+type LegacyImportTimeEntriesMultipartBody struct {
+	CsvFile   openapi_types.File `json:"csv_file"`
+	ProjectId int                `json:"project_id"`
+}
+
+// LegacyImportTimeEntriesParams defines parameters for LegacyImportTimeEntries.
+type LegacyImportTimeEntriesParams struct {
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// LegacyMergeTimeEntriesParams defines parameters for LegacyMergeTimeEntries.
+type LegacyMergeTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// LegacyListProjectTimeEntriesParams defines parameters for LegacyListProjectTimeEntries.
+type LegacyListProjectTimeEntriesParams struct {
+	ProjectId ProjectIDQuery `form:"project_id" json:"project_id"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                                     `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *LegacyListProjectTimeEntriesParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// LegacyListProjectTimeEntriesParamsBillable defines parameters for LegacyListProjectTimeEntries.
+type LegacyListProjectTimeEntriesParamsBillable string
+
+// LegacyDeleteTimeEntryParams defines parameters for LegacyDeleteTimeEntry.
+type LegacyDeleteTimeEntryParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyGetTimeEntryParams defines parameters for LegacyGetTimeEntry.
+type LegacyGetTimeEntryParams struct {
+	Id IDQuery `form:"id" json:"id"`
+}
+
+// LegacyPatchTimeEntryParams defines parameters for LegacyPatchTimeEntry.
+type LegacyPatchTimeEntryParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyReplaceTimeEntryParams defines parameters for LegacyReplaceTimeEntry.
+type LegacyReplaceTimeEntryParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacySplitTimeEntryParams defines parameters for LegacySplitTimeEntry.
+type LegacySplitTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// LegacySplitTimeEntryAtMidnightParams defines parameters for LegacySplitTimeEntryAtMidnight.
+type LegacySplitTimeEntryAtMidnightParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// LegacySetTimeEntryTagsParams defines parameters for LegacySetTimeEntryTags.
+type LegacySetTimeEntryTagsParams struct {
+	Id IDQuery `form:"id" json:"id"`
+
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LegacyApproveTimesheetParams defines parameters for LegacyApproveTimesheet.
+type LegacyApproveTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacyGetTimesheetPeriodParams defines parameters for LegacyGetTimesheetPeriod.
+type LegacyGetTimesheetPeriodParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacyListTimesheetPeriodsParams defines parameters for LegacyListTimesheetPeriods.
+type LegacyListTimesheetPeriodsParams struct {
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser   `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *TimesheetStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// LegacyRejectTimesheetParams defines parameters for LegacyRejectTimesheet.
+type LegacyRejectTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacySubmitTimesheetParams defines parameters for LegacySubmitTimesheet.
+type LegacySubmitTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacyGetWeeklyTimesheetParams defines parameters for LegacyGetWeeklyTimesheet.
+type LegacyGetWeeklyTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacyUpdateWeeklyTimesheetParams defines parameters for LegacyUpdateWeeklyTimesheet.
+type LegacyUpdateWeeklyTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetBrandingParams defines parameters for GetBranding.
+type GetBrandingParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// Effective Merge the client's branding with the default
+	Effective *Effective `form:"effective,omitempty" json:"effective,omitempty"`
+}
+
+// UpdateBrandingParams defines parameters for UpdateBranding.
+type UpdateBrandingParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// DeleteBrandingLogoParams defines parameters for DeleteBrandingLogo.
+type DeleteBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// GetBrandingLogoParams defines parameters for GetBrandingLogo.
+type GetBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// UploadBrandingLogoMultipartBody defines parameters for UploadBrandingLogo.
+type UploadBrandingLogoMultipartBody struct {
+	// Logo PNG or JPEG
+	Logo openapi_types.File `json:"logo"`
+}
+
+// UploadBrandingLogoParams defines parameters for UploadBrandingLogo.
+type UploadBrandingLogoParams struct {
+	// ClientId Client whose branding to use; omit for the default branding
+	ClientId *ClientIDQuery `form:"client_id,omitempty" json:"client_id,omitempty"`
+}
+
+// GetClientBrandingParams defines parameters for GetClientBranding.
+type GetClientBrandingParams struct {
+	// Effective Merge the client's branding with the default
+	Effective *Effective `form:"effective,omitempty" json:"effective,omitempty"`
+}
+
+// UploadClientBrandingLogoMultipartBody defines parameters for UploadClientBrandingLogo.
+type UploadClientBrandingLogoMultipartBody struct {
+	// Logo PNG or JPEG
+	Logo openapi_types.File `json:"logo"`
+}
+
+// CreateProjectParams defines parameters for CreateProject.
+type CreateProjectParams struct {
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteProjectParams defines parameters for DeleteProject.
+type DeleteProjectParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchProjectParams defines parameters for PatchProject.
+type PatchProjectParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReplaceProjectParams defines parameters for ReplaceProject.
+type ReplaceProjectParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ListProjectTimeEntriesParams defines parameters for ListProjectTimeEntries.
+type ListProjectTimeEntriesParams struct {
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                               `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *ListProjectTimeEntriesParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// ListProjectTimeEntriesParamsBillable defines parameters for ListProjectTimeEntries.
+type ListProjectTimeEntriesParamsBillable string
+
+// ExportReportParams defines parameters for ExportReport.
+type ExportReportParams struct {
+	Format *ExportReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// ProjectId Defaults to all projects
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                     `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *ExportReportParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// IncludePricing Set to false to leave out rates and amounts
+	IncludePricing *IncludePricing `form:"include_pricing,omitempty" json:"include_pricing,omitempty"`
+}
+
+// ExportReportParamsFormat defines parameters for ExportReport.
+type ExportReportParamsFormat string
+
+// ExportReportParamsBillable defines parameters for ExportReport.
+type ExportReportParamsBillable string
+
+// GetPDFReportParams defines parameters for GetPDFReport.
+type GetPDFReportParams struct {
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// ProjectIds Report on several projects with per-project subtotals
+	ProjectIds *[]int `form:"project_ids,omitempty" json:"project_ids,omitempty"`
+
+	// ClientId Report on all projects of a client
+	ClientId *int `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo   *DateTo                     `form:"date_to,omitempty" json:"date_to,omitempty"`
+	Billable *GetPDFReportParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// IncludePricing Set to false to leave out rates and amounts
+	IncludePricing *IncludePricing            `form:"include_pricing,omitempty" json:"include_pricing,omitempty"`
+	GroupBy        *GetPDFReportParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Collapse Show only the group subtotals
+	Collapse *bool `form:"collapse,omitempty" json:"collapse,omitempty"`
+
+	// Locale Defaults to the client's locale
+	Locale  *GetPDFReportParamsLocale    `form:"locale,omitempty" json:"locale,omitempty"`
+	Columns *[]GetPDFReportParamsColumns `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// RoundMinutes Round with this increment instead of the configured rounding
+	RoundMinutes *int                          `form:"round_minutes,omitempty" json:"round_minutes,omitempty"`
+	RoundMode    *GetPDFReportParamsRoundMode  `form:"round_mode,omitempty" json:"round_mode,omitempty"`
+	RoundScope   *GetPDFReportParamsRoundScope `form:"round_scope,omitempty" json:"round_scope,omitempty"`
+}
+
+// GetPDFReportParamsBillable defines parameters for GetPDFReport.
+type GetPDFReportParamsBillable string
+
+// GetPDFReportParamsGroupBy defines parameters for GetPDFReport.
+type GetPDFReportParamsGroupBy string
+
+// GetPDFReportParamsLocale defines parameters for GetPDFReport.
+type GetPDFReportParamsLocale string
+
+// GetPDFReportParamsColumns defines parameters for GetPDFReport.
+type GetPDFReportParamsColumns string
+
+// GetPDFReportParamsRoundMode defines parameters for GetPDFReport.
+type GetPDFReportParamsRoundMode string
+
+// GetPDFReportParamsRoundScope defines parameters for GetPDFReport.
+type GetPDFReportParamsRoundScope string
+
+// GetReportSummaryParams defines parameters for GetReportSummary.
+type GetReportSummaryParams struct {
+	// GroupBy At most one of day, week and month
+	GroupBy *[]GetReportSummaryParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DateFrom First day, in the requesting user's timezone
+	DateFrom *DateFrom `form:"date_from,omitempty" json:"date_from,omitempty"`
+
+	// DateTo Last day, in the requesting user's timezone
+	DateTo    *DateTo                         `form:"date_to,omitempty" json:"date_to,omitempty"`
+	ProjectId *int                            `form:"project_id,omitempty" json:"project_id,omitempty"`
+	ClientId  *int                            `form:"client_id,omitempty" json:"client_id,omitempty"`
+	Billable  *GetReportSummaryParamsBillable `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// GetReportSummaryParamsGroupBy defines parameters for GetReportSummary.
+type GetReportSummaryParamsGroupBy string
+
+// GetReportSummaryParamsBillable defines parameters for GetReportSummary.
+type GetReportSummaryParamsBillable string
+
+// GetSettingsParams defines parameters for GetSettings.
+type GetSettingsParams struct {
+	// Scope Set to workspace for the workspace's own settings
+	Scope *GetSettingsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// GetSettingsParamsScope defines parameters for GetSettings.
+type GetSettingsParamsScope string
+
+// PatchSettingsParams defines parameters for PatchSettings.
+type PatchSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReplaceSettingsParams defines parameters for ReplaceSettings.
+type ReplaceSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchUserSettingsParams defines parameters for PatchUserSettings.
+type PatchUserSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReplaceUserSettingsParams defines parameters for ReplaceUserSettings.
+type ReplaceUserSettingsParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// CreateTimeEntryParams defines parameters for CreateTimeEntry.
+type CreateTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// BulkDeleteTimeEntriesParams defines parameters for BulkDeleteTimeEntries.
+type BulkDeleteTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// BulkUpdateTimeEntriesParams defines parameters for BulkUpdateTimeEntries.
+type BulkUpdateTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// ImportTimeEntriesMultipartBody defines parameters for ImportTimeEntries.
+type ImportTimeEntriesMultipartBody struct {
+	CsvFile   openapi_types.File `json:"csv_file"`
+	ProjectId int                `json:"project_id"`
+}
+
+// ImportTimeEntriesParams defines parameters for ImportTimeEntries.
+type ImportTimeEntriesParams struct {
+	// IdempotencyKey Retries with the same key and body get the stored response instead of creating duplicates
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// MergeTimeEntriesParams defines parameters for MergeTimeEntries.
+type MergeTimeEntriesParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// SplitTimeEntryParams defines parameters for SplitTimeEntry.
+type SplitTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// DeleteTimeEntryParams defines parameters for DeleteTimeEntry.
+type DeleteTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchTimeEntryParams defines parameters for PatchTimeEntry.
+type PatchTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ReplaceTimeEntryParams defines parameters for ReplaceTimeEntry.
+type ReplaceTimeEntryParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SetTimeEntryBillableParams defines parameters for SetTimeEntryBillable.
+type SetTimeEntryBillableParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SplitTimeEntryAtMidnightParams defines parameters for SplitTimeEntryAtMidnight.
+type SplitTimeEntryAtMidnightParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+}
+
+// SetTimeEntryTagsParams defines parameters for SetTimeEntryTags.
+type SetTimeEntryTagsParams struct {
+	// OverrideLock Let admins change entries in a locked period
+	OverrideLock *OverrideLock `form:"override_lock,omitempty" json:"override_lock,omitempty"`
+
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ApproveTimesheetParams defines parameters for ApproveTimesheet.
+type ApproveTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetTimesheetPeriodParams defines parameters for GetTimesheetPeriod.
+type GetTimesheetPeriodParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// ListTimesheetPeriodsParams defines parameters for ListTimesheetPeriods.
+type ListTimesheetPeriodsParams struct {
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser   `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Status *TimesheetStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// RejectTimesheetParams defines parameters for RejectTimesheet.
+type RejectTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// SubmitTimesheetParams defines parameters for SubmitTimesheet.
+type SubmitTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetWeeklyTimesheetParams defines parameters for GetWeeklyTimesheet.
+type GetWeeklyTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// UpdateWeeklyTimesheetParams defines parameters for UpdateWeeklyTimesheet.
+type UpdateWeeklyTimesheetParams struct {
+	// Start Any day of the week; weeks start on the user's week_start_day
+	Start Week `form:"start" json:"start"`
+
+	// UserId Defaults to the requesting user
+	UserId *TimesheetUser `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// LegacyRemoveWorkspaceMemberParams defines parameters for LegacyRemoveWorkspaceMember.
+type LegacyRemoveWorkspaceMemberParams struct {
+	WorkspaceId WorkspaceIDQuery  `form:"workspace_id" json:"workspace_id"`
+	UserId      MemberUserIDQuery `form:"user_id" json:"user_id"`
+}
+
+// LegacyListWorkspaceMembersParams defines parameters for LegacyListWorkspaceMembers.
+type LegacyListWorkspaceMembersParams struct {
+	WorkspaceId WorkspaceIDQuery `form:"workspace_id" json:"workspace_id"`
+}
+
+// LegacyAddWorkspaceMemberParams defines parameters for LegacyAddWorkspaceMember.
+type LegacyAddWorkspaceMemberParams struct {
+	WorkspaceId WorkspaceIDQuery `form:"workspace_id" json:"workspace_id"`
+}
+
+// LegacyUpdateBrandingJSONRequestBody defines body for LegacyUpdateBranding for application/json ContentType.
+type LegacyUpdateBrandingJSONRequestBody = Branding
+
+// LegacyUploadBrandingLogoMultipartRequestBody defines body for LegacyUploadBrandingLogo for multipart/form-data ContentType.
+type LegacyUploadBrandingLogoMultipartRequestBody LegacyUploadBrandingLogoMultipartBody
+
+// LegacyCreateClientJSONRequestBody defines body for LegacyCreateClient for application/json ContentType.
+type LegacyCreateClientJSONRequestBody = Client
+
+// LegacyUpdateClientJSONRequestBody defines body for LegacyUpdateClient for application/json ContentType.
+type LegacyUpdateClientJSONRequestBody = Client
+
+// LegacyCreateProjectJSONRequestBody defines body for LegacyCreateProject for application/json ContentType.
+type LegacyCreateProjectJSONRequestBody = Project
+
+// LegacyAddProjectMemberJSONRequestBody defines body for LegacyAddProjectMember for application/json ContentType.
+type LegacyAddProjectMemberJSONRequestBody = ProjectMember
+
+// LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody defines body for LegacyPatchProject for application/merge-patch+json ContentType.
+type LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// LegacyReplaceProjectJSONRequestBody defines body for LegacyReplaceProject for application/json ContentType.
+type LegacyReplaceProjectJSONRequestBody = Project
+
+// LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody defines body for LegacyPatchSettings for application/merge-patch+json ContentType.
+type LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// LegacyReplaceSettingsJSONRequestBody defines body for LegacyReplaceSettings for application/json ContentType.
+type LegacyReplaceSettingsJSONRequestBody = Settings
+
+// LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody defines body for LegacyPatchUserSettings for application/merge-patch+json ContentType.
+type LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// LegacyReplaceUserSettingsJSONRequestBody defines body for LegacyReplaceUserSettings for application/json ContentType.
+type LegacyReplaceUserSettingsJSONRequestBody = UserSettings
+
+// LegacyCreateTagJSONRequestBody defines body for LegacyCreateTag for application/json ContentType.
+type LegacyCreateTagJSONRequestBody = Tag
+
+// LegacyCreateTimeEntryJSONRequestBody defines body for LegacyCreateTimeEntry for application/json ContentType.
+type LegacyCreateTimeEntryJSONRequestBody = TimeEntry
+
+// LegacySetTimeEntryBillableJSONRequestBody defines body for LegacySetTimeEntryBillable for application/json ContentType.
+type LegacySetTimeEntryBillableJSONRequestBody = BillableUpdate
+
+// LegacyBulkDeleteTimeEntriesJSONRequestBody defines body for LegacyBulkDeleteTimeEntries for application/json ContentType.
+type LegacyBulkDeleteTimeEntriesJSONRequestBody = BulkSelection
+
+// LegacyBulkUpdateTimeEntriesJSONRequestBody defines body for LegacyBulkUpdateTimeEntries for application/json ContentType.
+type LegacyBulkUpdateTimeEntriesJSONRequestBody = BulkUpdateRequest
+
+// LegacyImportTimeEntriesMultipartRequestBody defines body for LegacyImportTimeEntries for multipart/form-data ContentType.
+type LegacyImportTimeEntriesMultipartRequestBody LegacyImportTimeEntriesMultipartBody
+
+// LegacyMergeTimeEntriesJSONRequestBody defines body for LegacyMergeTimeEntries for application/json ContentType.
+type LegacyMergeTimeEntriesJSONRequestBody = MergeRequest
+
+// LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody defines body for LegacyPatchTimeEntry for application/merge-patch+json ContentType.
+type LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// LegacyReplaceTimeEntryJSONRequestBody defines body for LegacyReplaceTimeEntry for application/json ContentType.
+type LegacyReplaceTimeEntryJSONRequestBody = TimeEntry
+
+// LegacySplitTimeEntryJSONRequestBody defines body for LegacySplitTimeEntry for application/json ContentType.
+type LegacySplitTimeEntryJSONRequestBody = SplitRequest
+
+// LegacySetTimeEntryTagsJSONRequestBody defines body for LegacySetTimeEntryTags for application/json ContentType.
+type LegacySetTimeEntryTagsJSONRequestBody = TagsUpdate
+
+// LegacyApproveTimesheetJSONRequestBody defines body for LegacyApproveTimesheet for application/json ContentType.
+type LegacyApproveTimesheetJSONRequestBody = TimesheetComment
+
+// LegacyRejectTimesheetJSONRequestBody defines body for LegacyRejectTimesheet for application/json ContentType.
+type LegacyRejectTimesheetJSONRequestBody = TimesheetComment
+
+// LegacySubmitTimesheetJSONRequestBody defines body for LegacySubmitTimesheet for application/json ContentType.
+type LegacySubmitTimesheetJSONRequestBody = TimesheetComment
+
+// LegacyUpdateWeeklyTimesheetJSONRequestBody defines body for LegacyUpdateWeeklyTimesheet for application/json ContentType.
+type LegacyUpdateWeeklyTimesheetJSONRequestBody = Timesheet
+
+// UpdateBrandingJSONRequestBody defines body for UpdateBranding for application/json ContentType.
+type UpdateBrandingJSONRequestBody = Branding
+
+// UploadBrandingLogoMultipartRequestBody defines body for UploadBrandingLogo for multipart/form-data ContentType.
+type UploadBrandingLogoMultipartRequestBody UploadBrandingLogoMultipartBody
+
+// CreateClientJSONRequestBody defines body for CreateClient for application/json ContentType.
+type CreateClientJSONRequestBody = Client
+
+// UpdateClientBrandingJSONRequestBody defines body for UpdateClientBranding for application/json ContentType.
+type UpdateClientBrandingJSONRequestBody = Branding
+
+// UploadClientBrandingLogoMultipartRequestBody defines body for UploadClientBrandingLogo for multipart/form-data ContentType.
+type UploadClientBrandingLogoMultipartRequestBody UploadClientBrandingLogoMultipartBody
+
+// UpdateClientJSONRequestBody defines body for UpdateClient for application/json ContentType.
+type UpdateClientJSONRequestBody = Client
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = Project
+
+// PatchProjectApplicationMergePatchPlusJSONRequestBody defines body for PatchProject for application/merge-patch+json ContentType.
+type PatchProjectApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// ReplaceProjectJSONRequestBody defines body for ReplaceProject for application/json ContentType.
+type ReplaceProjectJSONRequestBody = Project
+
+// AddProjectMemberJSONRequestBody defines body for AddProjectMember for application/json ContentType.
+type AddProjectMemberJSONRequestBody = ProjectMember
+
+// PatchSettingsApplicationMergePatchPlusJSONRequestBody defines body for PatchSettings for application/merge-patch+json ContentType.
+type PatchSettingsApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// ReplaceSettingsJSONRequestBody defines body for ReplaceSettings for application/json ContentType.
+type ReplaceSettingsJSONRequestBody = Settings
+
+// PatchUserSettingsApplicationMergePatchPlusJSONRequestBody defines body for PatchUserSettings for application/merge-patch+json ContentType.
+type PatchUserSettingsApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// ReplaceUserSettingsJSONRequestBody defines body for ReplaceUserSettings for application/json ContentType.
+type ReplaceUserSettingsJSONRequestBody = UserSettings
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = Tag
+
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = TimeEntry
+
+// BulkDeleteTimeEntriesJSONRequestBody defines body for BulkDeleteTimeEntries for application/json ContentType.
+type BulkDeleteTimeEntriesJSONRequestBody = BulkSelection
+
+// BulkUpdateTimeEntriesJSONRequestBody defines body for BulkUpdateTimeEntries for application/json ContentType.
+type BulkUpdateTimeEntriesJSONRequestBody = BulkUpdateRequest
+
+// ImportTimeEntriesMultipartRequestBody defines body for ImportTimeEntries for multipart/form-data ContentType.
+type ImportTimeEntriesMultipartRequestBody ImportTimeEntriesMultipartBody
+
+// MergeTimeEntriesJSONRequestBody defines body for MergeTimeEntries for application/json ContentType.
+type MergeTimeEntriesJSONRequestBody = MergeRequest
+
+// SplitTimeEntryJSONRequestBody defines body for SplitTimeEntry for application/json ContentType.
+type SplitTimeEntryJSONRequestBody = SplitRequest
+
+// PatchTimeEntryApplicationMergePatchPlusJSONRequestBody defines body for PatchTimeEntry for application/merge-patch+json ContentType.
+type PatchTimeEntryApplicationMergePatchPlusJSONRequestBody = MergePatch
+
+// ReplaceTimeEntryJSONRequestBody defines body for ReplaceTimeEntry for application/json ContentType.
+type ReplaceTimeEntryJSONRequestBody = TimeEntry
+
+// SetTimeEntryBillableJSONRequestBody defines body for SetTimeEntryBillable for application/json ContentType.
+type SetTimeEntryBillableJSONRequestBody = BillableUpdate
+
+// SetTimeEntryTagsJSONRequestBody defines body for SetTimeEntryTags for application/json ContentType.
+type SetTimeEntryTagsJSONRequestBody = TagsUpdate
+
+// ApproveTimesheetJSONRequestBody defines body for ApproveTimesheet for application/json ContentType.
+type ApproveTimesheetJSONRequestBody = TimesheetComment
+
+// RejectTimesheetJSONRequestBody defines body for RejectTimesheet for application/json ContentType.
+type RejectTimesheetJSONRequestBody = TimesheetComment
+
+// SubmitTimesheetJSONRequestBody defines body for SubmitTimesheet for application/json ContentType.
+type SubmitTimesheetJSONRequestBody = TimesheetComment
+
+// UpdateWeeklyTimesheetJSONRequestBody defines body for UpdateWeeklyTimesheet for application/json ContentType.
+type UpdateWeeklyTimesheetJSONRequestBody = Timesheet
+
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = Workspace
+
+// AddWorkspaceMemberJSONRequestBody defines body for AddWorkspaceMember for application/json ContentType.
+type AddWorkspaceMemberJSONRequestBody = WorkspaceMember
+
+// LegacyCreateWorkspaceJSONRequestBody defines body for LegacyCreateWorkspace for application/json ContentType.
+type LegacyCreateWorkspaceJSONRequestBody = Workspace
+
+// LegacyAddWorkspaceMemberJSONRequestBody defines body for LegacyAddWorkspaceMember for application/json ContentType.
+type LegacyAddWorkspaceMemberJSONRequestBody = WorkspaceMember
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// APIClient which conforms to the OpenAPI3 specification for this service.
+type APIClient struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*APIClient) error
+
+// Creates a new APIClient, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*APIClient, error) {
+	// create a client with sane default values
+	client := APIClient{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *APIClient) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *APIClient) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// LegacyGetBranding request
+	LegacyGetBranding(ctx context.Context, params *LegacyGetBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyUpdateBrandingWithBody request with any body
+	LegacyUpdateBrandingWithBody(ctx context.Context, params *LegacyUpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyUpdateBranding(ctx context.Context, params *LegacyUpdateBrandingParams, body LegacyUpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyDeleteBrandingLogo request
+	LegacyDeleteBrandingLogo(ctx context.Context, params *LegacyDeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetBrandingLogo request
+	LegacyGetBrandingLogo(ctx context.Context, params *LegacyGetBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyUploadBrandingLogoWithBody request with any body
+	LegacyUploadBrandingLogoWithBody(ctx context.Context, params *LegacyUploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListClients request
+	LegacyListClients(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyCreateClientWithBody request with any body
+	LegacyCreateClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyCreateClient(ctx context.Context, body LegacyCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyDeleteClient request
+	LegacyDeleteClient(ctx context.Context, params *LegacyDeleteClientParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetClient request
+	LegacyGetClient(ctx context.Context, params *LegacyGetClientParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyUpdateClientWithBody request with any body
+	LegacyUpdateClientWithBody(ctx context.Context, params *LegacyUpdateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyUpdateClient(ctx context.Context, params *LegacyUpdateClientParams, body LegacyUpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListCurrencies request
+	LegacyListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListProjects request
+	LegacyListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyCreateProjectWithBody request with any body
+	LegacyCreateProjectWithBody(ctx context.Context, params *LegacyCreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyCreateProject(ctx context.Context, params *LegacyCreateProjectParams, body LegacyCreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyRemoveProjectMember request
+	LegacyRemoveProjectMember(ctx context.Context, params *LegacyRemoveProjectMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListProjectMembers request
+	LegacyListProjectMembers(ctx context.Context, params *LegacyListProjectMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyAddProjectMemberWithBody request with any body
+	LegacyAddProjectMemberWithBody(ctx context.Context, params *LegacyAddProjectMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyAddProjectMember(ctx context.Context, params *LegacyAddProjectMemberParams, body LegacyAddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyDeleteProject request
+	LegacyDeleteProject(ctx context.Context, params *LegacyDeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetProject request
+	LegacyGetProject(ctx context.Context, params *LegacyGetProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyPatchProjectWithBody request with any body
+	LegacyPatchProjectWithBody(ctx context.Context, params *LegacyPatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyPatchProjectWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchProjectParams, body LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyReplaceProjectWithBody request with any body
+	LegacyReplaceProjectWithBody(ctx context.Context, params *LegacyReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyReplaceProject(ctx context.Context, params *LegacyReplaceProjectParams, body LegacyReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyExportReport request
+	LegacyExportReport(ctx context.Context, params *LegacyExportReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetPDFReport request
+	LegacyGetPDFReport(ctx context.Context, params *LegacyGetPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetReportSummary request
+	LegacyGetReportSummary(ctx context.Context, params *LegacyGetReportSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetSettings request
+	LegacyGetSettings(ctx context.Context, params *LegacyGetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyPatchSettingsWithBody request with any body
+	LegacyPatchSettingsWithBody(ctx context.Context, params *LegacyPatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyPatchSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchSettingsParams, body LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyReplaceSettingsWithBody request with any body
+	LegacyReplaceSettingsWithBody(ctx context.Context, params *LegacyReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyReplaceSettings(ctx context.Context, params *LegacyReplaceSettingsParams, body LegacyReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetUserSettings request
+	LegacyGetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyPatchUserSettingsWithBody request with any body
+	LegacyPatchUserSettingsWithBody(ctx context.Context, params *LegacyPatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyPatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchUserSettingsParams, body LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyReplaceUserSettingsWithBody request with any body
+	LegacyReplaceUserSettingsWithBody(ctx context.Context, params *LegacyReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyReplaceUserSettings(ctx context.Context, params *LegacyReplaceUserSettingsParams, body LegacyReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListTags request
+	LegacyListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyCreateTagWithBody request with any body
+	LegacyCreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyCreateTag(ctx context.Context, body LegacyCreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListTimeEntries request
+	LegacyListTimeEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyCreateTimeEntryWithBody request with any body
+	LegacyCreateTimeEntryWithBody(ctx context.Context, params *LegacyCreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyCreateTimeEntry(ctx context.Context, params *LegacyCreateTimeEntryParams, body LegacyCreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacySetTimeEntryBillableWithBody request with any body
+	LegacySetTimeEntryBillableWithBody(ctx context.Context, params *LegacySetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacySetTimeEntryBillable(ctx context.Context, params *LegacySetTimeEntryBillableParams, body LegacySetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyBulkDeleteTimeEntriesWithBody request with any body
+	LegacyBulkDeleteTimeEntriesWithBody(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyBulkDeleteTimeEntries(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, body LegacyBulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyBulkUpdateTimeEntriesWithBody request with any body
+	LegacyBulkUpdateTimeEntriesWithBody(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyBulkUpdateTimeEntries(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, body LegacyBulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetTimeEntryHistory request
+	LegacyGetTimeEntryHistory(ctx context.Context, params *LegacyGetTimeEntryHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyImportTimeEntriesWithBody request with any body
+	LegacyImportTimeEntriesWithBody(ctx context.Context, params *LegacyImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyMergeTimeEntriesWithBody request with any body
+	LegacyMergeTimeEntriesWithBody(ctx context.Context, params *LegacyMergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyMergeTimeEntries(ctx context.Context, params *LegacyMergeTimeEntriesParams, body LegacyMergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListProjectTimeEntries request
+	LegacyListProjectTimeEntries(ctx context.Context, params *LegacyListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyDeleteTimeEntry request
+	LegacyDeleteTimeEntry(ctx context.Context, params *LegacyDeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetTimeEntry request
+	LegacyGetTimeEntry(ctx context.Context, params *LegacyGetTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyPatchTimeEntryWithBody request with any body
+	LegacyPatchTimeEntryWithBody(ctx context.Context, params *LegacyPatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyPatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchTimeEntryParams, body LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyReplaceTimeEntryWithBody request with any body
+	LegacyReplaceTimeEntryWithBody(ctx context.Context, params *LegacyReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyReplaceTimeEntry(ctx context.Context, params *LegacyReplaceTimeEntryParams, body LegacyReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacySplitTimeEntryWithBody request with any body
+	LegacySplitTimeEntryWithBody(ctx context.Context, params *LegacySplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacySplitTimeEntry(ctx context.Context, params *LegacySplitTimeEntryParams, body LegacySplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacySplitTimeEntryAtMidnight request
+	LegacySplitTimeEntryAtMidnight(ctx context.Context, params *LegacySplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacySetTimeEntryTagsWithBody request with any body
+	LegacySetTimeEntryTagsWithBody(ctx context.Context, params *LegacySetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacySetTimeEntryTags(ctx context.Context, params *LegacySetTimeEntryTagsParams, body LegacySetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyApproveTimesheetWithBody request with any body
+	LegacyApproveTimesheetWithBody(ctx context.Context, params *LegacyApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyApproveTimesheet(ctx context.Context, params *LegacyApproveTimesheetParams, body LegacyApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetTimesheetPeriod request
+	LegacyGetTimesheetPeriod(ctx context.Context, params *LegacyGetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListTimesheetPeriods request
+	LegacyListTimesheetPeriods(ctx context.Context, params *LegacyListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyRejectTimesheetWithBody request with any body
+	LegacyRejectTimesheetWithBody(ctx context.Context, params *LegacyRejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyRejectTimesheet(ctx context.Context, params *LegacyRejectTimesheetParams, body LegacyRejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacySubmitTimesheetWithBody request with any body
+	LegacySubmitTimesheetWithBody(ctx context.Context, params *LegacySubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacySubmitTimesheet(ctx context.Context, params *LegacySubmitTimesheetParams, body LegacySubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyGetWeeklyTimesheet request
+	LegacyGetWeeklyTimesheet(ctx context.Context, params *LegacyGetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyUpdateWeeklyTimesheetWithBody request with any body
+	LegacyUpdateWeeklyTimesheetWithBody(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyUpdateWeeklyTimesheet(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, body LegacyUpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListUsers request
+	LegacyListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBranding request
+	GetBranding(ctx context.Context, params *GetBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBrandingWithBody request with any body
+	UpdateBrandingWithBody(ctx context.Context, params *UpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBranding(ctx context.Context, params *UpdateBrandingParams, body UpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBrandingLogo request
+	DeleteBrandingLogo(ctx context.Context, params *DeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBrandingLogo request
+	GetBrandingLogo(ctx context.Context, params *GetBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadBrandingLogoWithBody request with any body
+	UploadBrandingLogoWithBody(ctx context.Context, params *UploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClients request
+	ListClients(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClientWithBody request with any body
+	CreateClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClient(ctx context.Context, body CreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientBranding request
+	GetClientBranding(ctx context.Context, clientId ClientID, params *GetClientBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClientBrandingWithBody request with any body
+	UpdateClientBrandingWithBody(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClientBranding(ctx context.Context, clientId ClientID, body UpdateClientBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClientBrandingLogo request
+	DeleteClientBrandingLogo(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientBrandingLogo request
+	GetClientBrandingLogo(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadClientBrandingLogoWithBody request with any body
+	UploadClientBrandingLogoWithBody(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClient request
+	DeleteClient(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClient request
+	GetClient(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClientWithBody request with any body
+	UpdateClientWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClient(ctx context.Context, id ID, body UpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCurrencies request
+	ListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjects request
+	ListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, params *CreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, params *CreateProjectParams, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, id ID, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchProjectWithBody request with any body
+	PatchProjectWithBody(ctx context.Context, id ID, params *PatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProjectWithApplicationMergePatchPlusJSONBody(ctx context.Context, id ID, params *PatchProjectParams, body PatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceProjectWithBody request with any body
+	ReplaceProjectWithBody(ctx context.Context, id ID, params *ReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceProject(ctx context.Context, id ID, params *ReplaceProjectParams, body ReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectMembers request
+	ListProjectMembers(ctx context.Context, projectId ProjectID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddProjectMemberWithBody request with any body
+	AddProjectMemberWithBody(ctx context.Context, projectId ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddProjectMember(ctx context.Context, projectId ProjectID, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveProjectMember request
+	RemoveProjectMember(ctx context.Context, projectId ProjectID, userId MemberUserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectTimeEntries request
+	ListProjectTimeEntries(ctx context.Context, projectId ProjectID, params *ListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportReport request
+	ExportReport(ctx context.Context, params *ExportReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPDFReport request
+	GetPDFReport(ctx context.Context, params *GetPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReportSummary request
+	GetReportSummary(ctx context.Context, params *GetReportSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSettings request
+	GetSettings(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchSettingsWithBody request with any body
+	PatchSettingsWithBody(ctx context.Context, params *PatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *PatchSettingsParams, body PatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceSettingsWithBody request with any body
+	ReplaceSettingsWithBody(ctx context.Context, params *ReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceSettings(ctx context.Context, params *ReplaceSettingsParams, body ReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSettings request
+	GetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchUserSettingsWithBody request with any body
+	PatchUserSettingsWithBody(ctx context.Context, params *PatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *PatchUserSettingsParams, body PatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceUserSettingsWithBody request with any body
+	ReplaceUserSettingsWithBody(ctx context.Context, params *ReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceUserSettings(ctx context.Context, params *ReplaceUserSettingsParams, body ReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTags request
+	ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTagWithBody request with any body
+	CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTimeEntries request
+	ListTimeEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTimeEntryWithBody request with any body
+	CreateTimeEntryWithBody(ctx context.Context, params *CreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTimeEntry(ctx context.Context, params *CreateTimeEntryParams, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkDeleteTimeEntriesWithBody request with any body
+	BulkDeleteTimeEntriesWithBody(ctx context.Context, params *BulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkDeleteTimeEntries(ctx context.Context, params *BulkDeleteTimeEntriesParams, body BulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkUpdateTimeEntriesWithBody request with any body
+	BulkUpdateTimeEntriesWithBody(ctx context.Context, params *BulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkUpdateTimeEntries(ctx context.Context, params *BulkUpdateTimeEntriesParams, body BulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTimeEntriesWithBody request with any body
+	ImportTimeEntriesWithBody(ctx context.Context, params *ImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergeTimeEntriesWithBody request with any body
+	MergeTimeEntriesWithBody(ctx context.Context, params *MergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MergeTimeEntries(ctx context.Context, params *MergeTimeEntriesParams, body MergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SplitTimeEntryWithBody request with any body
+	SplitTimeEntryWithBody(ctx context.Context, params *SplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SplitTimeEntry(ctx context.Context, params *SplitTimeEntryParams, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTimeEntry request
+	DeleteTimeEntry(ctx context.Context, id ID, params *DeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeEntry request
+	GetTimeEntry(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchTimeEntryWithBody request with any body
+	PatchTimeEntryWithBody(ctx context.Context, id ID, params *PatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, id ID, params *PatchTimeEntryParams, body PatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceTimeEntryWithBody request with any body
+	ReplaceTimeEntryWithBody(ctx context.Context, id ID, params *ReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceTimeEntry(ctx context.Context, id ID, params *ReplaceTimeEntryParams, body ReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTimeEntryBillableWithBody request with any body
+	SetTimeEntryBillableWithBody(ctx context.Context, id ID, params *SetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTimeEntryBillable(ctx context.Context, id ID, params *SetTimeEntryBillableParams, body SetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeEntryHistory request
+	GetTimeEntryHistory(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SplitTimeEntryAtMidnight request
+	SplitTimeEntryAtMidnight(ctx context.Context, id ID, params *SplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTimeEntryTagsWithBody request with any body
+	SetTimeEntryTagsWithBody(ctx context.Context, id ID, params *SetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTimeEntryTags(ctx context.Context, id ID, params *SetTimeEntryTagsParams, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveTimesheetWithBody request with any body
+	ApproveTimesheetWithBody(ctx context.Context, params *ApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveTimesheet(ctx context.Context, params *ApproveTimesheetParams, body ApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimesheetPeriod request
+	GetTimesheetPeriod(ctx context.Context, params *GetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTimesheetPeriods request
+	ListTimesheetPeriods(ctx context.Context, params *ListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RejectTimesheetWithBody request with any body
+	RejectTimesheetWithBody(ctx context.Context, params *RejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RejectTimesheet(ctx context.Context, params *RejectTimesheetParams, body RejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitTimesheetWithBody request with any body
+	SubmitTimesheetWithBody(ctx context.Context, params *SubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitTimesheet(ctx context.Context, params *SubmitTimesheetParams, body SubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWeeklyTimesheet request
+	GetWeeklyTimesheet(ctx context.Context, params *GetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWeeklyTimesheetWithBody request with any body
+	UpdateWeeklyTimesheetWithBody(ctx context.Context, params *UpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWeeklyTimesheet(ctx context.Context, params *UpdateWeeklyTimesheetParams, body UpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsers request
+	ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWorkspaces request
+	ListWorkspaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWorkspaceWithBody request with any body
+	CreateWorkspaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWorkspace(ctx context.Context, body CreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWorkspaceMembers request
+	ListWorkspaceMembers(ctx context.Context, workspaceId WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddWorkspaceMemberWithBody request with any body
+	AddWorkspaceMemberWithBody(ctx context.Context, workspaceId WorkspaceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddWorkspaceMember(ctx context.Context, workspaceId WorkspaceID, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveWorkspaceMember request
+	RemoveWorkspaceMember(ctx context.Context, workspaceId WorkspaceID, userId MemberUserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListWorkspaces request
+	LegacyListWorkspaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyCreateWorkspaceWithBody request with any body
+	LegacyCreateWorkspaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyCreateWorkspace(ctx context.Context, body LegacyCreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyRemoveWorkspaceMember request
+	LegacyRemoveWorkspaceMember(ctx context.Context, params *LegacyRemoveWorkspaceMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyListWorkspaceMembers request
+	LegacyListWorkspaceMembers(ctx context.Context, params *LegacyListWorkspaceMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LegacyAddWorkspaceMemberWithBody request with any body
+	LegacyAddWorkspaceMemberWithBody(ctx context.Context, params *LegacyAddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LegacyAddWorkspaceMember(ctx context.Context, params *LegacyAddWorkspaceMemberParams, body LegacyAddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HealthCheck request
+	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *APIClient) LegacyGetBranding(ctx context.Context, params *LegacyGetBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetBrandingRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateBrandingWithBody(ctx context.Context, params *LegacyUpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateBrandingRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateBranding(ctx context.Context, params *LegacyUpdateBrandingParams, body LegacyUpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateBrandingRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyDeleteBrandingLogo(ctx context.Context, params *LegacyDeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyDeleteBrandingLogoRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetBrandingLogo(ctx context.Context, params *LegacyGetBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetBrandingLogoRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUploadBrandingLogoWithBody(ctx context.Context, params *LegacyUploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUploadBrandingLogoRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListClients(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListClientsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateClientRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateClient(ctx context.Context, body LegacyCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateClientRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyDeleteClient(ctx context.Context, params *LegacyDeleteClientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyDeleteClientRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetClient(ctx context.Context, params *LegacyGetClientParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetClientRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateClientWithBody(ctx context.Context, params *LegacyUpdateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateClientRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateClient(ctx context.Context, params *LegacyUpdateClientParams, body LegacyUpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateClientRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListCurrenciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListProjectsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateProjectWithBody(ctx context.Context, params *LegacyCreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateProjectRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateProject(ctx context.Context, params *LegacyCreateProjectParams, body LegacyCreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateProjectRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyRemoveProjectMember(ctx context.Context, params *LegacyRemoveProjectMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyRemoveProjectMemberRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListProjectMembers(ctx context.Context, params *LegacyListProjectMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListProjectMembersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyAddProjectMemberWithBody(ctx context.Context, params *LegacyAddProjectMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyAddProjectMemberRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyAddProjectMember(ctx context.Context, params *LegacyAddProjectMemberParams, body LegacyAddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyAddProjectMemberRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyDeleteProject(ctx context.Context, params *LegacyDeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyDeleteProjectRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetProject(ctx context.Context, params *LegacyGetProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetProjectRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchProjectWithBody(ctx context.Context, params *LegacyPatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchProjectRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchProjectWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchProjectParams, body LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchProjectRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceProjectWithBody(ctx context.Context, params *LegacyReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceProjectRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceProject(ctx context.Context, params *LegacyReplaceProjectParams, body LegacyReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceProjectRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyExportReport(ctx context.Context, params *LegacyExportReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyExportReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetPDFReport(ctx context.Context, params *LegacyGetPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetPDFReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetReportSummary(ctx context.Context, params *LegacyGetReportSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetReportSummaryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetSettings(ctx context.Context, params *LegacyGetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetSettingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchSettingsWithBody(ctx context.Context, params *LegacyPatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchSettingsParams, body LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchSettingsRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceSettingsWithBody(ctx context.Context, params *LegacyReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceSettings(ctx context.Context, params *LegacyReplaceSettingsParams, body LegacyReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceSettingsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetUserSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchUserSettingsWithBody(ctx context.Context, params *LegacyPatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchUserSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchUserSettingsParams, body LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceUserSettingsWithBody(ctx context.Context, params *LegacyReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceUserSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceUserSettings(ctx context.Context, params *LegacyReplaceUserSettingsParams, body LegacyReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceUserSettingsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListTagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateTagRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateTag(ctx context.Context, body LegacyCreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateTagRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListTimeEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListTimeEntriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateTimeEntryWithBody(ctx context.Context, params *LegacyCreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateTimeEntry(ctx context.Context, params *LegacyCreateTimeEntryParams, body LegacyCreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySetTimeEntryBillableWithBody(ctx context.Context, params *LegacySetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySetTimeEntryBillableRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySetTimeEntryBillable(ctx context.Context, params *LegacySetTimeEntryBillableParams, body LegacySetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySetTimeEntryBillableRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyBulkDeleteTimeEntriesWithBody(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyBulkDeleteTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyBulkDeleteTimeEntries(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, body LegacyBulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyBulkDeleteTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyBulkUpdateTimeEntriesWithBody(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyBulkUpdateTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyBulkUpdateTimeEntries(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, body LegacyBulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyBulkUpdateTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetTimeEntryHistory(ctx context.Context, params *LegacyGetTimeEntryHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetTimeEntryHistoryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyImportTimeEntriesWithBody(ctx context.Context, params *LegacyImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyImportTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyMergeTimeEntriesWithBody(ctx context.Context, params *LegacyMergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyMergeTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyMergeTimeEntries(ctx context.Context, params *LegacyMergeTimeEntriesParams, body LegacyMergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyMergeTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListProjectTimeEntries(ctx context.Context, params *LegacyListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListProjectTimeEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyDeleteTimeEntry(ctx context.Context, params *LegacyDeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyDeleteTimeEntryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetTimeEntry(ctx context.Context, params *LegacyGetTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetTimeEntryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchTimeEntryWithBody(ctx context.Context, params *LegacyPatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyPatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *LegacyPatchTimeEntryParams, body LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceTimeEntryWithBody(ctx context.Context, params *LegacyReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyReplaceTimeEntry(ctx context.Context, params *LegacyReplaceTimeEntryParams, body LegacyReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyReplaceTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySplitTimeEntryWithBody(ctx context.Context, params *LegacySplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySplitTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySplitTimeEntry(ctx context.Context, params *LegacySplitTimeEntryParams, body LegacySplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySplitTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySplitTimeEntryAtMidnight(ctx context.Context, params *LegacySplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySplitTimeEntryAtMidnightRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySetTimeEntryTagsWithBody(ctx context.Context, params *LegacySetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySetTimeEntryTagsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySetTimeEntryTags(ctx context.Context, params *LegacySetTimeEntryTagsParams, body LegacySetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySetTimeEntryTagsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyApproveTimesheetWithBody(ctx context.Context, params *LegacyApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyApproveTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyApproveTimesheet(ctx context.Context, params *LegacyApproveTimesheetParams, body LegacyApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyApproveTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetTimesheetPeriod(ctx context.Context, params *LegacyGetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetTimesheetPeriodRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListTimesheetPeriods(ctx context.Context, params *LegacyListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListTimesheetPeriodsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyRejectTimesheetWithBody(ctx context.Context, params *LegacyRejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyRejectTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyRejectTimesheet(ctx context.Context, params *LegacyRejectTimesheetParams, body LegacyRejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyRejectTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySubmitTimesheetWithBody(ctx context.Context, params *LegacySubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySubmitTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacySubmitTimesheet(ctx context.Context, params *LegacySubmitTimesheetParams, body LegacySubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacySubmitTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyGetWeeklyTimesheet(ctx context.Context, params *LegacyGetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyGetWeeklyTimesheetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateWeeklyTimesheetWithBody(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateWeeklyTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyUpdateWeeklyTimesheet(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, body LegacyUpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyUpdateWeeklyTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetBranding(ctx context.Context, params *GetBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBrandingRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateBrandingWithBody(ctx context.Context, params *UpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBrandingRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateBranding(ctx context.Context, params *UpdateBrandingParams, body UpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBrandingRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) DeleteBrandingLogo(ctx context.Context, params *DeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBrandingLogoRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetBrandingLogo(ctx context.Context, params *GetBrandingLogoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBrandingLogoRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UploadBrandingLogoWithBody(ctx context.Context, params *UploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadBrandingLogoRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListClients(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateClient(ctx context.Context, body CreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetClientBranding(ctx context.Context, clientId ClientID, params *GetClientBrandingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientBrandingRequest(c.Server, clientId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateClientBrandingWithBody(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientBrandingRequestWithBody(c.Server, clientId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateClientBranding(ctx context.Context, clientId ClientID, body UpdateClientBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientBrandingRequest(c.Server, clientId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) DeleteClientBrandingLogo(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientBrandingLogoRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetClientBrandingLogo(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientBrandingLogoRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UploadClientBrandingLogoWithBody(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadClientBrandingLogoRequestWithBody(c.Server, clientId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) DeleteClient(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetClient(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateClientWithBody(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateClient(ctx context.Context, id ID, body UpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCurrenciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateProjectWithBody(ctx context.Context, params *CreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateProject(ctx context.Context, params *CreateProjectParams, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) DeleteProject(ctx context.Context, id ID, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetProject(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchProjectWithBody(ctx context.Context, id ID, params *PatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchProjectWithApplicationMergePatchPlusJSONBody(ctx context.Context, id ID, params *PatchProjectParams, body PatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceProjectWithBody(ctx context.Context, id ID, params *ReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceProjectRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceProject(ctx context.Context, id ID, params *ReplaceProjectParams, body ReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceProjectRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListProjectMembers(ctx context.Context, projectId ProjectID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectMembersRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) AddProjectMemberWithBody(ctx context.Context, projectId ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectMemberRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) AddProjectMember(ctx context.Context, projectId ProjectID, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectMemberRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) RemoveProjectMember(ctx context.Context, projectId ProjectID, userId MemberUserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveProjectMemberRequest(c.Server, projectId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListProjectTimeEntries(ctx context.Context, projectId ProjectID, params *ListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectTimeEntriesRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ExportReport(ctx context.Context, params *ExportReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetPDFReport(ctx context.Context, params *GetPDFReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPDFReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetReportSummary(ctx context.Context, params *GetReportSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportSummaryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetSettings(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchSettingsWithBody(ctx context.Context, params *PatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *PatchSettingsParams, body PatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSettingsRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceSettingsWithBody(ctx context.Context, params *ReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceSettings(ctx context.Context, params *ReplaceSettingsParams, body ReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceSettingsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchUserSettingsWithBody(ctx context.Context, params *PatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx context.Context, params *PatchUserSettingsParams, body PatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceUserSettingsWithBody(ctx context.Context, params *ReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceUserSettingsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceUserSettings(ctx context.Context, params *ReplaceUserSettingsParams, body ReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceUserSettingsRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListTimeEntries(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTimeEntriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateTimeEntryWithBody(ctx context.Context, params *CreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateTimeEntry(ctx context.Context, params *CreateTimeEntryParams, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) BulkDeleteTimeEntriesWithBody(ctx context.Context, params *BulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkDeleteTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) BulkDeleteTimeEntries(ctx context.Context, params *BulkDeleteTimeEntriesParams, body BulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkDeleteTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) BulkUpdateTimeEntriesWithBody(ctx context.Context, params *BulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) BulkUpdateTimeEntries(ctx context.Context, params *BulkUpdateTimeEntriesParams, body BulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ImportTimeEntriesWithBody(ctx context.Context, params *ImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) MergeTimeEntriesWithBody(ctx context.Context, params *MergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeTimeEntriesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) MergeTimeEntries(ctx context.Context, params *MergeTimeEntriesParams, body MergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeTimeEntriesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SplitTimeEntryWithBody(ctx context.Context, params *SplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SplitTimeEntry(ctx context.Context, params *SplitTimeEntryParams, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) DeleteTimeEntry(ctx context.Context, id ID, params *DeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTimeEntryRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetTimeEntry(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchTimeEntryWithBody(ctx context.Context, id ID, params *PatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTimeEntryRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) PatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx context.Context, id ID, params *PatchTimeEntryParams, body PatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceTimeEntryWithBody(ctx context.Context, id ID, params *ReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceTimeEntryRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ReplaceTimeEntry(ctx context.Context, id ID, params *ReplaceTimeEntryParams, body ReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceTimeEntryRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SetTimeEntryBillableWithBody(ctx context.Context, id ID, params *SetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryBillableRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SetTimeEntryBillable(ctx context.Context, id ID, params *SetTimeEntryBillableParams, body SetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryBillableRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetTimeEntryHistory(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeEntryHistoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SplitTimeEntryAtMidnight(ctx context.Context, id ID, params *SplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitTimeEntryAtMidnightRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SetTimeEntryTagsWithBody(ctx context.Context, id ID, params *SetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryTagsRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SetTimeEntryTags(ctx context.Context, id ID, params *SetTimeEntryTagsParams, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryTagsRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ApproveTimesheetWithBody(ctx context.Context, params *ApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ApproveTimesheet(ctx context.Context, params *ApproveTimesheetParams, body ApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetTimesheetPeriod(ctx context.Context, params *GetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimesheetPeriodRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListTimesheetPeriods(ctx context.Context, params *ListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTimesheetPeriodsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) RejectTimesheetWithBody(ctx context.Context, params *RejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) RejectTimesheet(ctx context.Context, params *RejectTimesheetParams, body RejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SubmitTimesheetWithBody(ctx context.Context, params *SubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) SubmitTimesheet(ctx context.Context, params *SubmitTimesheetParams, body SubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) GetWeeklyTimesheet(ctx context.Context, params *GetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWeeklyTimesheetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateWeeklyTimesheetWithBody(ctx context.Context, params *UpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWeeklyTimesheetRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) UpdateWeeklyTimesheet(ctx context.Context, params *UpdateWeeklyTimesheetParams, body UpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWeeklyTimesheetRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListWorkspaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkspacesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateWorkspaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkspaceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) CreateWorkspace(ctx context.Context, body CreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkspaceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) ListWorkspaceMembers(ctx context.Context, workspaceId WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkspaceMembersRequest(c.Server, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) AddWorkspaceMemberWithBody(ctx context.Context, workspaceId WorkspaceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddWorkspaceMemberRequestWithBody(c.Server, workspaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) AddWorkspaceMember(ctx context.Context, workspaceId WorkspaceID, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddWorkspaceMemberRequest(c.Server, workspaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) RemoveWorkspaceMember(ctx context.Context, workspaceId WorkspaceID, userId MemberUserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveWorkspaceMemberRequest(c.Server, workspaceId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListWorkspaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListWorkspacesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateWorkspaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateWorkspaceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyCreateWorkspace(ctx context.Context, body LegacyCreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyCreateWorkspaceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyRemoveWorkspaceMember(ctx context.Context, params *LegacyRemoveWorkspaceMemberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyRemoveWorkspaceMemberRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyListWorkspaceMembers(ctx context.Context, params *LegacyListWorkspaceMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyListWorkspaceMembersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyAddWorkspaceMemberWithBody(ctx context.Context, params *LegacyAddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyAddWorkspaceMemberRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) LegacyAddWorkspaceMember(ctx context.Context, params *LegacyAddWorkspaceMemberParams, body LegacyAddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLegacyAddWorkspaceMemberRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *APIClient) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewLegacyGetBrandingRequest generates requests for LegacyGetBranding
+func NewLegacyGetBrandingRequest(server string, params *LegacyGetBrandingParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/branding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Effective != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "effective", runtime.ParamLocationQuery, *params.Effective); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyUpdateBrandingRequest calls the generic LegacyUpdateBranding builder with application/json body
+func NewLegacyUpdateBrandingRequest(server string, params *LegacyUpdateBrandingParams, body LegacyUpdateBrandingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyUpdateBrandingRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyUpdateBrandingRequestWithBody generates requests for LegacyUpdateBranding with any type of body
+func NewLegacyUpdateBrandingRequestWithBody(server string, params *LegacyUpdateBrandingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/branding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyDeleteBrandingLogoRequest generates requests for LegacyDeleteBrandingLogo
+func NewLegacyDeleteBrandingLogoRequest(server string, params *LegacyDeleteBrandingLogoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetBrandingLogoRequest generates requests for LegacyGetBrandingLogo
+func NewLegacyGetBrandingLogoRequest(server string, params *LegacyGetBrandingLogoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyUploadBrandingLogoRequestWithBody generates requests for LegacyUploadBrandingLogo with any type of body
+func NewLegacyUploadBrandingLogoRequestWithBody(server string, params *LegacyUploadBrandingLogoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyListClientsRequest generates requests for LegacyListClients
+func NewLegacyListClientsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyCreateClientRequest calls the generic LegacyCreateClient builder with application/json body
+func NewLegacyCreateClientRequest(server string, body LegacyCreateClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyCreateClientRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLegacyCreateClientRequestWithBody generates requests for LegacyCreateClient with any type of body
+func NewLegacyCreateClientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyDeleteClientRequest generates requests for LegacyDeleteClient
+func NewLegacyDeleteClientRequest(server string, params *LegacyDeleteClientParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clients/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetClientRequest generates requests for LegacyGetClient
+func NewLegacyGetClientRequest(server string, params *LegacyGetClientParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clients/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyUpdateClientRequest calls the generic LegacyUpdateClient builder with application/json body
+func NewLegacyUpdateClientRequest(server string, params *LegacyUpdateClientParams, body LegacyUpdateClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyUpdateClientRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyUpdateClientRequestWithBody generates requests for LegacyUpdateClient with any type of body
+func NewLegacyUpdateClientRequestWithBody(server string, params *LegacyUpdateClientParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/clients/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyListCurrenciesRequest generates requests for LegacyListCurrencies
+func NewLegacyListCurrenciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/currencies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyListProjectsRequest generates requests for LegacyListProjects
+func NewLegacyListProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyCreateProjectRequest calls the generic LegacyCreateProject builder with application/json body
+func NewLegacyCreateProjectRequest(server string, params *LegacyCreateProjectParams, body LegacyCreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyCreateProjectRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyCreateProjectRequestWithBody generates requests for LegacyCreateProject with any type of body
+func NewLegacyCreateProjectRequestWithBody(server string, params *LegacyCreateProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyRemoveProjectMemberRequest generates requests for LegacyRemoveProjectMember
+func NewLegacyRemoveProjectMemberRequest(server string, params *LegacyRemoveProjectMemberParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, params.ProjectId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyListProjectMembersRequest generates requests for LegacyListProjectMembers
+func NewLegacyListProjectMembersRequest(server string, params *LegacyListProjectMembersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, params.ProjectId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyAddProjectMemberRequest calls the generic LegacyAddProjectMember builder with application/json body
+func NewLegacyAddProjectMemberRequest(server string, params *LegacyAddProjectMemberParams, body LegacyAddProjectMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyAddProjectMemberRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyAddProjectMemberRequestWithBody generates requests for LegacyAddProjectMember with any type of body
+func NewLegacyAddProjectMemberRequestWithBody(server string, params *LegacyAddProjectMemberParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, params.ProjectId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyDeleteProjectRequest generates requests for LegacyDeleteProject
+func NewLegacyDeleteProjectRequest(server string, params *LegacyDeleteProjectParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetProjectRequest generates requests for LegacyGetProject
+func NewLegacyGetProjectRequest(server string, params *LegacyGetProjectParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyPatchProjectRequestWithApplicationMergePatchPlusJSONBody calls the generic LegacyPatchProject builder with application/merge-patch+json body
+func NewLegacyPatchProjectRequestWithApplicationMergePatchPlusJSONBody(server string, params *LegacyPatchProjectParams, body LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyPatchProjectRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewLegacyPatchProjectRequestWithBody generates requests for LegacyPatchProject with any type of body
+func NewLegacyPatchProjectRequestWithBody(server string, params *LegacyPatchProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyReplaceProjectRequest calls the generic LegacyReplaceProject builder with application/json body
+func NewLegacyReplaceProjectRequest(server string, params *LegacyReplaceProjectParams, body LegacyReplaceProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyReplaceProjectRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyReplaceProjectRequestWithBody generates requests for LegacyReplaceProject with any type of body
+func NewLegacyReplaceProjectRequestWithBody(server string, params *LegacyReplaceProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyExportReportRequest generates requests for LegacyExportReport
+func NewLegacyExportReportRequest(server string, params *LegacyExportReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludePricing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_pricing", runtime.ParamLocationQuery, *params.IncludePricing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetPDFReportRequest generates requests for LegacyGetPDFReport
+func NewLegacyGetPDFReportRequest(server string, params *LegacyGetPDFReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/pdf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "project_ids", runtime.ParamLocationQuery, *params.ProjectIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludePricing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_pricing", runtime.ParamLocationQuery, *params.IncludePricing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Collapse != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "collapse", runtime.ParamLocationQuery, *params.Collapse); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Locale != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Columns != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "columns", runtime.ParamLocationQuery, *params.Columns); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_minutes", runtime.ParamLocationQuery, *params.RoundMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundMode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_mode", runtime.ParamLocationQuery, *params.RoundMode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundScope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_scope", runtime.ParamLocationQuery, *params.RoundScope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetReportSummaryRequest generates requests for LegacyGetReportSummary
+func NewLegacyGetReportSummaryRequest(server string, params *LegacyGetReportSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetSettingsRequest generates requests for LegacyGetSettings
+func NewLegacyGetSettingsRequest(server string, params *LegacyGetSettingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Scope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyPatchSettingsRequestWithApplicationMergePatchPlusJSONBody calls the generic LegacyPatchSettings builder with application/merge-patch+json body
+func NewLegacyPatchSettingsRequestWithApplicationMergePatchPlusJSONBody(server string, params *LegacyPatchSettingsParams, body LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyPatchSettingsRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewLegacyPatchSettingsRequestWithBody generates requests for LegacyPatchSettings with any type of body
+func NewLegacyPatchSettingsRequestWithBody(server string, params *LegacyPatchSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyReplaceSettingsRequest calls the generic LegacyReplaceSettings builder with application/json body
+func NewLegacyReplaceSettingsRequest(server string, params *LegacyReplaceSettingsParams, body LegacyReplaceSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyReplaceSettingsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyReplaceSettingsRequestWithBody generates requests for LegacyReplaceSettings with any type of body
+func NewLegacyReplaceSettingsRequestWithBody(server string, params *LegacyReplaceSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetUserSettingsRequest generates requests for LegacyGetUserSettings
+func NewLegacyGetUserSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody calls the generic LegacyPatchUserSettings builder with application/merge-patch+json body
+func NewLegacyPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody(server string, params *LegacyPatchUserSettingsParams, body LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyPatchUserSettingsRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewLegacyPatchUserSettingsRequestWithBody generates requests for LegacyPatchUserSettings with any type of body
+func NewLegacyPatchUserSettingsRequestWithBody(server string, params *LegacyPatchUserSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyReplaceUserSettingsRequest calls the generic LegacyReplaceUserSettings builder with application/json body
+func NewLegacyReplaceUserSettingsRequest(server string, params *LegacyReplaceUserSettingsParams, body LegacyReplaceUserSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyReplaceUserSettingsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyReplaceUserSettingsRequestWithBody generates requests for LegacyReplaceUserSettings with any type of body
+func NewLegacyReplaceUserSettingsRequestWithBody(server string, params *LegacyReplaceUserSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyListTagsRequest generates requests for LegacyListTags
+func NewLegacyListTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyCreateTagRequest calls the generic LegacyCreateTag builder with application/json body
+func NewLegacyCreateTagRequest(server string, body LegacyCreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLegacyCreateTagRequestWithBody generates requests for LegacyCreateTag with any type of body
+func NewLegacyCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyListTimeEntriesRequest generates requests for LegacyListTimeEntries
+func NewLegacyListTimeEntriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyCreateTimeEntryRequest calls the generic LegacyCreateTimeEntry builder with application/json body
+func NewLegacyCreateTimeEntryRequest(server string, params *LegacyCreateTimeEntryParams, body LegacyCreateTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyCreateTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyCreateTimeEntryRequestWithBody generates requests for LegacyCreateTimeEntry with any type of body
+func NewLegacyCreateTimeEntryRequestWithBody(server string, params *LegacyCreateTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacySetTimeEntryBillableRequest calls the generic LegacySetTimeEntryBillable builder with application/json body
+func NewLegacySetTimeEntryBillableRequest(server string, params *LegacySetTimeEntryBillableParams, body LegacySetTimeEntryBillableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacySetTimeEntryBillableRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacySetTimeEntryBillableRequestWithBody generates requests for LegacySetTimeEntryBillable with any type of body
+func NewLegacySetTimeEntryBillableRequestWithBody(server string, params *LegacySetTimeEntryBillableParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/billable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyBulkDeleteTimeEntriesRequest calls the generic LegacyBulkDeleteTimeEntries builder with application/json body
+func NewLegacyBulkDeleteTimeEntriesRequest(server string, params *LegacyBulkDeleteTimeEntriesParams, body LegacyBulkDeleteTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyBulkDeleteTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyBulkDeleteTimeEntriesRequestWithBody generates requests for LegacyBulkDeleteTimeEntries with any type of body
+func NewLegacyBulkDeleteTimeEntriesRequestWithBody(server string, params *LegacyBulkDeleteTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyBulkUpdateTimeEntriesRequest calls the generic LegacyBulkUpdateTimeEntries builder with application/json body
+func NewLegacyBulkUpdateTimeEntriesRequest(server string, params *LegacyBulkUpdateTimeEntriesParams, body LegacyBulkUpdateTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyBulkUpdateTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyBulkUpdateTimeEntriesRequestWithBody generates requests for LegacyBulkUpdateTimeEntries with any type of body
+func NewLegacyBulkUpdateTimeEntriesRequestWithBody(server string, params *LegacyBulkUpdateTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyGetTimeEntryHistoryRequest generates requests for LegacyGetTimeEntryHistory
+func NewLegacyGetTimeEntryHistoryRequest(server string, params *LegacyGetTimeEntryHistoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyImportTimeEntriesRequestWithBody generates requests for LegacyImportTimeEntries with any type of body
+func NewLegacyImportTimeEntriesRequestWithBody(server string, params *LegacyImportTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyMergeTimeEntriesRequest calls the generic LegacyMergeTimeEntries builder with application/json body
+func NewLegacyMergeTimeEntriesRequest(server string, params *LegacyMergeTimeEntriesParams, body LegacyMergeTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyMergeTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyMergeTimeEntriesRequestWithBody generates requests for LegacyMergeTimeEntries with any type of body
+func NewLegacyMergeTimeEntriesRequestWithBody(server string, params *LegacyMergeTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyListProjectTimeEntriesRequest generates requests for LegacyListProjectTimeEntries
+func NewLegacyListProjectTimeEntriesRequest(server string, params *LegacyListProjectTimeEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/project")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, params.ProjectId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyDeleteTimeEntryRequest generates requests for LegacyDeleteTimeEntry
+func NewLegacyDeleteTimeEntryRequest(server string, params *LegacyDeleteTimeEntryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyGetTimeEntryRequest generates requests for LegacyGetTimeEntry
+func NewLegacyGetTimeEntryRequest(server string, params *LegacyGetTimeEntryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody calls the generic LegacyPatchTimeEntry builder with application/merge-patch+json body
+func NewLegacyPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody(server string, params *LegacyPatchTimeEntryParams, body LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyPatchTimeEntryRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewLegacyPatchTimeEntryRequestWithBody generates requests for LegacyPatchTimeEntry with any type of body
+func NewLegacyPatchTimeEntryRequestWithBody(server string, params *LegacyPatchTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyReplaceTimeEntryRequest calls the generic LegacyReplaceTimeEntry builder with application/json body
+func NewLegacyReplaceTimeEntryRequest(server string, params *LegacyReplaceTimeEntryParams, body LegacyReplaceTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyReplaceTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyReplaceTimeEntryRequestWithBody generates requests for LegacyReplaceTimeEntry with any type of body
+func NewLegacyReplaceTimeEntryRequestWithBody(server string, params *LegacyReplaceTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/single")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacySplitTimeEntryRequest calls the generic LegacySplitTimeEntry builder with application/json body
+func NewLegacySplitTimeEntryRequest(server string, params *LegacySplitTimeEntryParams, body LegacySplitTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacySplitTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacySplitTimeEntryRequestWithBody generates requests for LegacySplitTimeEntry with any type of body
+func NewLegacySplitTimeEntryRequestWithBody(server string, params *LegacySplitTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/split")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacySplitTimeEntryAtMidnightRequest generates requests for LegacySplitTimeEntryAtMidnight
+func NewLegacySplitTimeEntryAtMidnightRequest(server string, params *LegacySplitTimeEntryAtMidnightParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/split-midnight")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacySetTimeEntryTagsRequest calls the generic LegacySetTimeEntryTags builder with application/json body
+func NewLegacySetTimeEntryTagsRequest(server string, params *LegacySetTimeEntryTagsParams, body LegacySetTimeEntryTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacySetTimeEntryTagsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacySetTimeEntryTagsRequestWithBody generates requests for LegacySetTimeEntryTags with any type of body
+func NewLegacySetTimeEntryTagsRequestWithBody(server string, params *LegacySetTimeEntryTagsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, params.Id); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLegacyApproveTimesheetRequest calls the generic LegacyApproveTimesheet builder with application/json body
+func NewLegacyApproveTimesheetRequest(server string, params *LegacyApproveTimesheetParams, body LegacyApproveTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyApproveTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyApproveTimesheetRequestWithBody generates requests for LegacyApproveTimesheet with any type of body
+func NewLegacyApproveTimesheetRequestWithBody(server string, params *LegacyApproveTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/approve")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyGetTimesheetPeriodRequest generates requests for LegacyGetTimesheetPeriod
+func NewLegacyGetTimesheetPeriodRequest(server string, params *LegacyGetTimesheetPeriodParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/period")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyListTimesheetPeriodsRequest generates requests for LegacyListTimesheetPeriods
+func NewLegacyListTimesheetPeriodsRequest(server string, params *LegacyListTimesheetPeriodsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyRejectTimesheetRequest calls the generic LegacyRejectTimesheet builder with application/json body
+func NewLegacyRejectTimesheetRequest(server string, params *LegacyRejectTimesheetParams, body LegacyRejectTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyRejectTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyRejectTimesheetRequestWithBody generates requests for LegacyRejectTimesheet with any type of body
+func NewLegacyRejectTimesheetRequestWithBody(server string, params *LegacyRejectTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/reject")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacySubmitTimesheetRequest calls the generic LegacySubmitTimesheet builder with application/json body
+func NewLegacySubmitTimesheetRequest(server string, params *LegacySubmitTimesheetParams, body LegacySubmitTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacySubmitTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacySubmitTimesheetRequestWithBody generates requests for LegacySubmitTimesheet with any type of body
+func NewLegacySubmitTimesheetRequestWithBody(server string, params *LegacySubmitTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/submit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyGetWeeklyTimesheetRequest generates requests for LegacyGetWeeklyTimesheet
+func NewLegacyGetWeeklyTimesheetRequest(server string, params *LegacyGetWeeklyTimesheetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/week")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyUpdateWeeklyTimesheetRequest calls the generic LegacyUpdateWeeklyTimesheet builder with application/json body
+func NewLegacyUpdateWeeklyTimesheetRequest(server string, params *LegacyUpdateWeeklyTimesheetParams, body LegacyUpdateWeeklyTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyUpdateWeeklyTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyUpdateWeeklyTimesheetRequestWithBody generates requests for LegacyUpdateWeeklyTimesheet with any type of body
+func NewLegacyUpdateWeeklyTimesheetRequestWithBody(server string, params *LegacyUpdateWeeklyTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/timesheets/week")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyListUsersRequest generates requests for LegacyListUsers
+func NewLegacyListUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBrandingRequest generates requests for GetBranding
+func NewGetBrandingRequest(server string, params *GetBrandingParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/branding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Effective != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "effective", runtime.ParamLocationQuery, *params.Effective); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBrandingRequest calls the generic UpdateBranding builder with application/json body
+func NewUpdateBrandingRequest(server string, params *UpdateBrandingParams, body UpdateBrandingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBrandingRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateBrandingRequestWithBody generates requests for UpdateBranding with any type of body
+func NewUpdateBrandingRequestWithBody(server string, params *UpdateBrandingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/branding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBrandingLogoRequest generates requests for DeleteBrandingLogo
+func NewDeleteBrandingLogoRequest(server string, params *DeleteBrandingLogoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBrandingLogoRequest generates requests for GetBrandingLogo
+func NewGetBrandingLogoRequest(server string, params *GetBrandingLogoParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadBrandingLogoRequestWithBody generates requests for UploadBrandingLogo with any type of body
+func NewUploadBrandingLogoRequestWithBody(server string, params *UploadBrandingLogoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/branding/logo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListClientsRequest generates requests for ListClients
+func NewListClientsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClientRequest calls the generic CreateClient builder with application/json body
+func NewCreateClientRequest(server string, body CreateClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClientRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateClientRequestWithBody generates requests for CreateClient with any type of body
+func NewCreateClientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClientBrandingRequest generates requests for GetClientBranding
+func NewGetClientBrandingRequest(server string, clientId ClientID, params *GetClientBrandingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s/branding", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Effective != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "effective", runtime.ParamLocationQuery, *params.Effective); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClientBrandingRequest calls the generic UpdateClientBranding builder with application/json body
+func NewUpdateClientBrandingRequest(server string, clientId ClientID, body UpdateClientBrandingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClientBrandingRequestWithBody(server, clientId, "application/json", bodyReader)
+}
+
+// NewUpdateClientBrandingRequestWithBody generates requests for UpdateClientBranding with any type of body
+func NewUpdateClientBrandingRequestWithBody(server string, clientId ClientID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s/branding", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClientBrandingLogoRequest generates requests for DeleteClientBrandingLogo
+func NewDeleteClientBrandingLogoRequest(server string, clientId ClientID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s/branding/logo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientBrandingLogoRequest generates requests for GetClientBrandingLogo
+func NewGetClientBrandingLogoRequest(server string, clientId ClientID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s/branding/logo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadClientBrandingLogoRequestWithBody generates requests for UploadClientBrandingLogo with any type of body
+func NewUploadClientBrandingLogoRequestWithBody(server string, clientId ClientID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s/branding/logo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClientRequest generates requests for DeleteClient
+func NewDeleteClientRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientRequest generates requests for GetClient
+func NewGetClientRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClientRequest calls the generic UpdateClient builder with application/json body
+func NewUpdateClientRequest(server string, id ID, body UpdateClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClientRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateClientRequestWithBody generates requests for UpdateClient with any type of body
+func NewUpdateClientRequestWithBody(server string, id ID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCurrenciesRequest generates requests for ListCurrencies
+func NewListCurrenciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/currencies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, params *CreateProjectParams, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, params *CreateProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, id ID, params *DeleteProjectParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchProjectRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchProject builder with application/merge-patch+json body
+func NewPatchProjectRequestWithApplicationMergePatchPlusJSONBody(server string, id ID, params *PatchProjectParams, body PatchProjectApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchProjectRequestWithBody generates requests for PatchProject with any type of body
+func NewPatchProjectRequestWithBody(server string, id ID, params *PatchProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReplaceProjectRequest calls the generic ReplaceProject builder with application/json body
+func NewReplaceProjectRequest(server string, id ID, params *ReplaceProjectParams, body ReplaceProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceProjectRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewReplaceProjectRequestWithBody generates requests for ReplaceProject with any type of body
+func NewReplaceProjectRequestWithBody(server string, id ID, params *ReplaceProjectParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListProjectMembersRequest generates requests for ListProjectMembers
+func NewListProjectMembersRequest(server string, projectId ProjectID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddProjectMemberRequest calls the generic AddProjectMember builder with application/json body
+func NewAddProjectMemberRequest(server string, projectId ProjectID, body AddProjectMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddProjectMemberRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewAddProjectMemberRequestWithBody generates requests for AddProjectMember with any type of body
+func NewAddProjectMemberRequestWithBody(server string, projectId ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveProjectMemberRequest generates requests for RemoveProjectMember
+func NewRemoveProjectMemberRequest(server string, projectId ProjectID, userId MemberUserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectTimeEntriesRequest generates requests for ListProjectTimeEntries
+func NewListProjectTimeEntriesRequest(server string, projectId ProjectID, params *ListProjectTimeEntriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/%s/time-entries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportReportRequest generates requests for ExportReport
+func NewExportReportRequest(server string, params *ExportReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reports/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludePricing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_pricing", runtime.ParamLocationQuery, *params.IncludePricing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPDFReportRequest generates requests for GetPDFReport
+func NewGetPDFReportRequest(server string, params *GetPDFReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reports/pdf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "project_ids", runtime.ParamLocationQuery, *params.ProjectIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludePricing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_pricing", runtime.ParamLocationQuery, *params.IncludePricing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Collapse != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "collapse", runtime.ParamLocationQuery, *params.Collapse); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Locale != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Columns != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "columns", runtime.ParamLocationQuery, *params.Columns); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_minutes", runtime.ParamLocationQuery, *params.RoundMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundMode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_mode", runtime.ParamLocationQuery, *params.RoundMode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RoundScope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_scope", runtime.ParamLocationQuery, *params.RoundScope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReportSummaryRequest generates requests for GetReportSummary
+func NewGetReportSummaryRequest(server string, params *GetReportSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reports/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_from", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date_to", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string, params *GetSettingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Scope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchSettingsRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchSettings builder with application/merge-patch+json body
+func NewPatchSettingsRequestWithApplicationMergePatchPlusJSONBody(server string, params *PatchSettingsParams, body PatchSettingsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchSettingsRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchSettingsRequestWithBody generates requests for PatchSettings with any type of body
+func NewPatchSettingsRequestWithBody(server string, params *PatchSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReplaceSettingsRequest calls the generic ReplaceSettings builder with application/json body
+func NewReplaceSettingsRequest(server string, params *ReplaceSettingsParams, body ReplaceSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceSettingsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewReplaceSettingsRequestWithBody generates requests for ReplaceSettings with any type of body
+func NewReplaceSettingsRequestWithBody(server string, params *ReplaceSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserSettingsRequest generates requests for GetUserSettings
+func NewGetUserSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchUserSettings builder with application/merge-patch+json body
+func NewPatchUserSettingsRequestWithApplicationMergePatchPlusJSONBody(server string, params *PatchUserSettingsParams, body PatchUserSettingsApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUserSettingsRequestWithBody(server, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchUserSettingsRequestWithBody generates requests for PatchUserSettings with any type of body
+func NewPatchUserSettingsRequestWithBody(server string, params *PatchUserSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReplaceUserSettingsRequest calls the generic ReplaceUserSettings builder with application/json body
+func NewReplaceUserSettingsRequest(server string, params *ReplaceUserSettingsParams, body ReplaceUserSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceUserSettingsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewReplaceUserSettingsRequestWithBody generates requests for ReplaceUserSettings with any type of body
+func NewReplaceUserSettingsRequestWithBody(server string, params *ReplaceUserSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/settings/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTimeEntriesRequest generates requests for ListTimeEntries
+func NewListTimeEntriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTimeEntryRequest calls the generic CreateTimeEntry builder with application/json body
+func NewCreateTimeEntryRequest(server string, params *CreateTimeEntryParams, body CreateTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTimeEntryRequestWithBody generates requests for CreateTimeEntry with any type of body
+func NewCreateTimeEntryRequestWithBody(server string, params *CreateTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewBulkDeleteTimeEntriesRequest calls the generic BulkDeleteTimeEntries builder with application/json body
+func NewBulkDeleteTimeEntriesRequest(server string, params *BulkDeleteTimeEntriesParams, body BulkDeleteTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkDeleteTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewBulkDeleteTimeEntriesRequestWithBody generates requests for BulkDeleteTimeEntries with any type of body
+func NewBulkDeleteTimeEntriesRequestWithBody(server string, params *BulkDeleteTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBulkUpdateTimeEntriesRequest calls the generic BulkUpdateTimeEntries builder with application/json body
+func NewBulkUpdateTimeEntriesRequest(server string, params *BulkUpdateTimeEntriesParams, body BulkUpdateTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkUpdateTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewBulkUpdateTimeEntriesRequestWithBody generates requests for BulkUpdateTimeEntries with any type of body
+func NewBulkUpdateTimeEntriesRequestWithBody(server string, params *BulkUpdateTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportTimeEntriesRequestWithBody generates requests for ImportTimeEntries with any type of body
+func NewImportTimeEntriesRequestWithBody(server string, params *ImportTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewMergeTimeEntriesRequest calls the generic MergeTimeEntries builder with application/json body
+func NewMergeTimeEntriesRequest(server string, params *MergeTimeEntriesParams, body MergeTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergeTimeEntriesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewMergeTimeEntriesRequestWithBody generates requests for MergeTimeEntries with any type of body
+func NewMergeTimeEntriesRequestWithBody(server string, params *MergeTimeEntriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSplitTimeEntryRequest calls the generic SplitTimeEntry builder with application/json body
+func NewSplitTimeEntryRequest(server string, params *SplitTimeEntryParams, body SplitTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSplitTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSplitTimeEntryRequestWithBody generates requests for SplitTimeEntry with any type of body
+func NewSplitTimeEntryRequestWithBody(server string, params *SplitTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/split")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTimeEntryRequest generates requests for DeleteTimeEntry
+func NewDeleteTimeEntryRequest(server string, id ID, params *DeleteTimeEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTimeEntryRequest generates requests for GetTimeEntry
+func NewGetTimeEntryRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchTimeEntry builder with application/merge-patch+json body
+func NewPatchTimeEntryRequestWithApplicationMergePatchPlusJSONBody(server string, id ID, params *PatchTimeEntryParams, body PatchTimeEntryApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTimeEntryRequestWithBody(server, id, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchTimeEntryRequestWithBody generates requests for PatchTimeEntry with any type of body
+func NewPatchTimeEntryRequestWithBody(server string, id ID, params *PatchTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReplaceTimeEntryRequest calls the generic ReplaceTimeEntry builder with application/json body
+func NewReplaceTimeEntryRequest(server string, id ID, params *ReplaceTimeEntryParams, body ReplaceTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceTimeEntryRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewReplaceTimeEntryRequestWithBody generates requests for ReplaceTimeEntry with any type of body
+func NewReplaceTimeEntryRequestWithBody(server string, id ID, params *ReplaceTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSetTimeEntryBillableRequest calls the generic SetTimeEntryBillable builder with application/json body
+func NewSetTimeEntryBillableRequest(server string, id ID, params *SetTimeEntryBillableParams, body SetTimeEntryBillableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTimeEntryBillableRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetTimeEntryBillableRequestWithBody generates requests for SetTimeEntryBillable with any type of body
+func NewSetTimeEntryBillableRequestWithBody(server string, id ID, params *SetTimeEntryBillableParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s/billable", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTimeEntryHistoryRequest generates requests for GetTimeEntryHistory
+func NewGetTimeEntryHistoryRequest(server string, id ID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSplitTimeEntryAtMidnightRequest generates requests for SplitTimeEntryAtMidnight
+func NewSplitTimeEntryAtMidnightRequest(server string, id ID, params *SplitTimeEntryAtMidnightParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s/split-midnight", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetTimeEntryTagsRequest calls the generic SetTimeEntryTags builder with application/json body
+func NewSetTimeEntryTagsRequest(server string, id ID, params *SetTimeEntryTagsParams, body SetTimeEntryTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTimeEntryTagsRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetTimeEntryTagsRequestWithBody generates requests for SetTimeEntryTags with any type of body
+func NewSetTimeEntryTagsRequestWithBody(server string, id ID, params *SetTimeEntryTagsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/time-entries/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OverrideLock != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "override_lock", runtime.ParamLocationQuery, *params.OverrideLock); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewApproveTimesheetRequest calls the generic ApproveTimesheet builder with application/json body
+func NewApproveTimesheetRequest(server string, params *ApproveTimesheetParams, body ApproveTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewApproveTimesheetRequestWithBody generates requests for ApproveTimesheet with any type of body
+func NewApproveTimesheetRequestWithBody(server string, params *ApproveTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/approve")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTimesheetPeriodRequest generates requests for GetTimesheetPeriod
+func NewGetTimesheetPeriodRequest(server string, params *GetTimesheetPeriodParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/period")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTimesheetPeriodsRequest generates requests for ListTimesheetPeriods
+func NewListTimesheetPeriodsRequest(server string, params *ListTimesheetPeriodsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRejectTimesheetRequest calls the generic RejectTimesheet builder with application/json body
+func NewRejectTimesheetRequest(server string, params *RejectTimesheetParams, body RejectTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRejectTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRejectTimesheetRequestWithBody generates requests for RejectTimesheet with any type of body
+func NewRejectTimesheetRequestWithBody(server string, params *RejectTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/reject")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSubmitTimesheetRequest calls the generic SubmitTimesheet builder with application/json body
+func NewSubmitTimesheetRequest(server string, params *SubmitTimesheetParams, body SubmitTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSubmitTimesheetRequestWithBody generates requests for SubmitTimesheet with any type of body
+func NewSubmitTimesheetRequestWithBody(server string, params *SubmitTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/submit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWeeklyTimesheetRequest generates requests for GetWeeklyTimesheet
+func NewGetWeeklyTimesheetRequest(server string, params *GetWeeklyTimesheetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/week")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWeeklyTimesheetRequest calls the generic UpdateWeeklyTimesheet builder with application/json body
+func NewUpdateWeeklyTimesheetRequest(server string, params *UpdateWeeklyTimesheetParams, body UpdateWeeklyTimesheetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWeeklyTimesheetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateWeeklyTimesheetRequestWithBody generates requests for UpdateWeeklyTimesheet with any type of body
+func NewUpdateWeeklyTimesheetRequestWithBody(server string, params *UpdateWeeklyTimesheetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/timesheets/week")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWorkspacesRequest generates requests for ListWorkspaces
+func NewListWorkspacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWorkspaceRequest calls the generic CreateWorkspace builder with application/json body
+func NewCreateWorkspaceRequest(server string, body CreateWorkspaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkspaceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWorkspaceRequestWithBody generates requests for CreateWorkspace with any type of body
+func NewCreateWorkspaceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWorkspaceMembersRequest generates requests for ListWorkspaceMembers
+func NewListWorkspaceMembersRequest(server string, workspaceId WorkspaceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workspace_id", runtime.ParamLocationPath, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workspaces/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddWorkspaceMemberRequest calls the generic AddWorkspaceMember builder with application/json body
+func NewAddWorkspaceMemberRequest(server string, workspaceId WorkspaceID, body AddWorkspaceMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddWorkspaceMemberRequestWithBody(server, workspaceId, "application/json", bodyReader)
+}
+
+// NewAddWorkspaceMemberRequestWithBody generates requests for AddWorkspaceMember with any type of body
+func NewAddWorkspaceMemberRequestWithBody(server string, workspaceId WorkspaceID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workspace_id", runtime.ParamLocationPath, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workspaces/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveWorkspaceMemberRequest generates requests for RemoveWorkspaceMember
+func NewRemoveWorkspaceMemberRequest(server string, workspaceId WorkspaceID, userId MemberUserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workspace_id", runtime.ParamLocationPath, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workspaces/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyListWorkspacesRequest generates requests for LegacyListWorkspaces
+func NewLegacyListWorkspacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyCreateWorkspaceRequest calls the generic LegacyCreateWorkspace builder with application/json body
+func NewLegacyCreateWorkspaceRequest(server string, body LegacyCreateWorkspaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyCreateWorkspaceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLegacyCreateWorkspaceRequestWithBody generates requests for LegacyCreateWorkspace with any type of body
+func NewLegacyCreateWorkspaceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLegacyRemoveWorkspaceMemberRequest generates requests for LegacyRemoveWorkspaceMember
+func NewLegacyRemoveWorkspaceMemberRequest(server string, params *LegacyRemoveWorkspaceMemberParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workspaces/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspace_id", runtime.ParamLocationQuery, params.WorkspaceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyListWorkspaceMembersRequest generates requests for LegacyListWorkspaceMembers
+func NewLegacyListWorkspaceMembersRequest(server string, params *LegacyListWorkspaceMembersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workspaces/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspace_id", runtime.ParamLocationQuery, params.WorkspaceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLegacyAddWorkspaceMemberRequest calls the generic LegacyAddWorkspaceMember builder with application/json body
+func NewLegacyAddWorkspaceMemberRequest(server string, params *LegacyAddWorkspaceMemberParams, body LegacyAddWorkspaceMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLegacyAddWorkspaceMemberRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewLegacyAddWorkspaceMemberRequestWithBody generates requests for LegacyAddWorkspaceMember with any type of body
+func NewLegacyAddWorkspaceMemberRequestWithBody(server string, params *LegacyAddWorkspaceMemberParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/workspaces/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workspace_id", runtime.ParamLocationQuery, params.WorkspaceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *APIClient) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *APIClient) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LegacyGetBrandingWithResponse request
+	LegacyGetBrandingWithResponse(ctx context.Context, params *LegacyGetBrandingParams, reqEditors ...RequestEditorFn) (*LegacyGetBrandingResponse, error)
+
+	// LegacyUpdateBrandingWithBodyWithResponse request with any body
+	LegacyUpdateBrandingWithBodyWithResponse(ctx context.Context, params *LegacyUpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateBrandingResponse, error)
+
+	LegacyUpdateBrandingWithResponse(ctx context.Context, params *LegacyUpdateBrandingParams, body LegacyUpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateBrandingResponse, error)
+
+	// LegacyDeleteBrandingLogoWithResponse request
+	LegacyDeleteBrandingLogoWithResponse(ctx context.Context, params *LegacyDeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*LegacyDeleteBrandingLogoResponse, error)
+
+	// LegacyGetBrandingLogoWithResponse request
+	LegacyGetBrandingLogoWithResponse(ctx context.Context, params *LegacyGetBrandingLogoParams, reqEditors ...RequestEditorFn) (*LegacyGetBrandingLogoResponse, error)
+
+	// LegacyUploadBrandingLogoWithBodyWithResponse request with any body
+	LegacyUploadBrandingLogoWithBodyWithResponse(ctx context.Context, params *LegacyUploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUploadBrandingLogoResponse, error)
+
+	// LegacyListClientsWithResponse request
+	LegacyListClientsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListClientsResponse, error)
+
+	// LegacyCreateClientWithBodyWithResponse request with any body
+	LegacyCreateClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateClientResponse, error)
+
+	LegacyCreateClientWithResponse(ctx context.Context, body LegacyCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateClientResponse, error)
+
+	// LegacyDeleteClientWithResponse request
+	LegacyDeleteClientWithResponse(ctx context.Context, params *LegacyDeleteClientParams, reqEditors ...RequestEditorFn) (*LegacyDeleteClientResponse, error)
+
+	// LegacyGetClientWithResponse request
+	LegacyGetClientWithResponse(ctx context.Context, params *LegacyGetClientParams, reqEditors ...RequestEditorFn) (*LegacyGetClientResponse, error)
+
+	// LegacyUpdateClientWithBodyWithResponse request with any body
+	LegacyUpdateClientWithBodyWithResponse(ctx context.Context, params *LegacyUpdateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateClientResponse, error)
+
+	LegacyUpdateClientWithResponse(ctx context.Context, params *LegacyUpdateClientParams, body LegacyUpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateClientResponse, error)
+
+	// LegacyListCurrenciesWithResponse request
+	LegacyListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListCurrenciesResponse, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error)
+
+	// LegacyListProjectsWithResponse request
+	LegacyListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListProjectsResponse, error)
+
+	// LegacyCreateProjectWithBodyWithResponse request with any body
+	LegacyCreateProjectWithBodyWithResponse(ctx context.Context, params *LegacyCreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateProjectResponse, error)
+
+	LegacyCreateProjectWithResponse(ctx context.Context, params *LegacyCreateProjectParams, body LegacyCreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateProjectResponse, error)
+
+	// LegacyRemoveProjectMemberWithResponse request
+	LegacyRemoveProjectMemberWithResponse(ctx context.Context, params *LegacyRemoveProjectMemberParams, reqEditors ...RequestEditorFn) (*LegacyRemoveProjectMemberResponse, error)
+
+	// LegacyListProjectMembersWithResponse request
+	LegacyListProjectMembersWithResponse(ctx context.Context, params *LegacyListProjectMembersParams, reqEditors ...RequestEditorFn) (*LegacyListProjectMembersResponse, error)
+
+	// LegacyAddProjectMemberWithBodyWithResponse request with any body
+	LegacyAddProjectMemberWithBodyWithResponse(ctx context.Context, params *LegacyAddProjectMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyAddProjectMemberResponse, error)
+
+	LegacyAddProjectMemberWithResponse(ctx context.Context, params *LegacyAddProjectMemberParams, body LegacyAddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyAddProjectMemberResponse, error)
+
+	// LegacyDeleteProjectWithResponse request
+	LegacyDeleteProjectWithResponse(ctx context.Context, params *LegacyDeleteProjectParams, reqEditors ...RequestEditorFn) (*LegacyDeleteProjectResponse, error)
+
+	// LegacyGetProjectWithResponse request
+	LegacyGetProjectWithResponse(ctx context.Context, params *LegacyGetProjectParams, reqEditors ...RequestEditorFn) (*LegacyGetProjectResponse, error)
+
+	// LegacyPatchProjectWithBodyWithResponse request with any body
+	LegacyPatchProjectWithBodyWithResponse(ctx context.Context, params *LegacyPatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchProjectResponse, error)
+
+	LegacyPatchProjectWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchProjectParams, body LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchProjectResponse, error)
+
+	// LegacyReplaceProjectWithBodyWithResponse request with any body
+	LegacyReplaceProjectWithBodyWithResponse(ctx context.Context, params *LegacyReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceProjectResponse, error)
+
+	LegacyReplaceProjectWithResponse(ctx context.Context, params *LegacyReplaceProjectParams, body LegacyReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceProjectResponse, error)
+
+	// LegacyExportReportWithResponse request
+	LegacyExportReportWithResponse(ctx context.Context, params *LegacyExportReportParams, reqEditors ...RequestEditorFn) (*LegacyExportReportResponse, error)
+
+	// LegacyGetPDFReportWithResponse request
+	LegacyGetPDFReportWithResponse(ctx context.Context, params *LegacyGetPDFReportParams, reqEditors ...RequestEditorFn) (*LegacyGetPDFReportResponse, error)
+
+	// LegacyGetReportSummaryWithResponse request
+	LegacyGetReportSummaryWithResponse(ctx context.Context, params *LegacyGetReportSummaryParams, reqEditors ...RequestEditorFn) (*LegacyGetReportSummaryResponse, error)
+
+	// LegacyGetSettingsWithResponse request
+	LegacyGetSettingsWithResponse(ctx context.Context, params *LegacyGetSettingsParams, reqEditors ...RequestEditorFn) (*LegacyGetSettingsResponse, error)
+
+	// LegacyPatchSettingsWithBodyWithResponse request with any body
+	LegacyPatchSettingsWithBodyWithResponse(ctx context.Context, params *LegacyPatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchSettingsResponse, error)
+
+	LegacyPatchSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchSettingsParams, body LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchSettingsResponse, error)
+
+	// LegacyReplaceSettingsWithBodyWithResponse request with any body
+	LegacyReplaceSettingsWithBodyWithResponse(ctx context.Context, params *LegacyReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceSettingsResponse, error)
+
+	LegacyReplaceSettingsWithResponse(ctx context.Context, params *LegacyReplaceSettingsParams, body LegacyReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceSettingsResponse, error)
+
+	// LegacyGetUserSettingsWithResponse request
+	LegacyGetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyGetUserSettingsResponse, error)
+
+	// LegacyPatchUserSettingsWithBodyWithResponse request with any body
+	LegacyPatchUserSettingsWithBodyWithResponse(ctx context.Context, params *LegacyPatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchUserSettingsResponse, error)
+
+	LegacyPatchUserSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchUserSettingsParams, body LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchUserSettingsResponse, error)
+
+	// LegacyReplaceUserSettingsWithBodyWithResponse request with any body
+	LegacyReplaceUserSettingsWithBodyWithResponse(ctx context.Context, params *LegacyReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceUserSettingsResponse, error)
+
+	LegacyReplaceUserSettingsWithResponse(ctx context.Context, params *LegacyReplaceUserSettingsParams, body LegacyReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceUserSettingsResponse, error)
+
+	// LegacyListTagsWithResponse request
+	LegacyListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListTagsResponse, error)
+
+	// LegacyCreateTagWithBodyWithResponse request with any body
+	LegacyCreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateTagResponse, error)
+
+	LegacyCreateTagWithResponse(ctx context.Context, body LegacyCreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateTagResponse, error)
+
+	// LegacyListTimeEntriesWithResponse request
+	LegacyListTimeEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListTimeEntriesResponse, error)
+
+	// LegacyCreateTimeEntryWithBodyWithResponse request with any body
+	LegacyCreateTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyCreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateTimeEntryResponse, error)
+
+	LegacyCreateTimeEntryWithResponse(ctx context.Context, params *LegacyCreateTimeEntryParams, body LegacyCreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateTimeEntryResponse, error)
+
+	// LegacySetTimeEntryBillableWithBodyWithResponse request with any body
+	LegacySetTimeEntryBillableWithBodyWithResponse(ctx context.Context, params *LegacySetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryBillableResponse, error)
+
+	LegacySetTimeEntryBillableWithResponse(ctx context.Context, params *LegacySetTimeEntryBillableParams, body LegacySetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryBillableResponse, error)
+
+	// LegacyBulkDeleteTimeEntriesWithBodyWithResponse request with any body
+	LegacyBulkDeleteTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyBulkDeleteTimeEntriesResponse, error)
+
+	LegacyBulkDeleteTimeEntriesWithResponse(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, body LegacyBulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyBulkDeleteTimeEntriesResponse, error)
+
+	// LegacyBulkUpdateTimeEntriesWithBodyWithResponse request with any body
+	LegacyBulkUpdateTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyBulkUpdateTimeEntriesResponse, error)
+
+	LegacyBulkUpdateTimeEntriesWithResponse(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, body LegacyBulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyBulkUpdateTimeEntriesResponse, error)
+
+	// LegacyGetTimeEntryHistoryWithResponse request
+	LegacyGetTimeEntryHistoryWithResponse(ctx context.Context, params *LegacyGetTimeEntryHistoryParams, reqEditors ...RequestEditorFn) (*LegacyGetTimeEntryHistoryResponse, error)
+
+	// LegacyImportTimeEntriesWithBodyWithResponse request with any body
+	LegacyImportTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyImportTimeEntriesResponse, error)
+
+	// LegacyMergeTimeEntriesWithBodyWithResponse request with any body
+	LegacyMergeTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyMergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyMergeTimeEntriesResponse, error)
+
+	LegacyMergeTimeEntriesWithResponse(ctx context.Context, params *LegacyMergeTimeEntriesParams, body LegacyMergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyMergeTimeEntriesResponse, error)
+
+	// LegacyListProjectTimeEntriesWithResponse request
+	LegacyListProjectTimeEntriesWithResponse(ctx context.Context, params *LegacyListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*LegacyListProjectTimeEntriesResponse, error)
+
+	// LegacyDeleteTimeEntryWithResponse request
+	LegacyDeleteTimeEntryWithResponse(ctx context.Context, params *LegacyDeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*LegacyDeleteTimeEntryResponse, error)
+
+	// LegacyGetTimeEntryWithResponse request
+	LegacyGetTimeEntryWithResponse(ctx context.Context, params *LegacyGetTimeEntryParams, reqEditors ...RequestEditorFn) (*LegacyGetTimeEntryResponse, error)
+
+	// LegacyPatchTimeEntryWithBodyWithResponse request with any body
+	LegacyPatchTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyPatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchTimeEntryResponse, error)
+
+	LegacyPatchTimeEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchTimeEntryParams, body LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchTimeEntryResponse, error)
+
+	// LegacyReplaceTimeEntryWithBodyWithResponse request with any body
+	LegacyReplaceTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceTimeEntryResponse, error)
+
+	LegacyReplaceTimeEntryWithResponse(ctx context.Context, params *LegacyReplaceTimeEntryParams, body LegacyReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceTimeEntryResponse, error)
+
+	// LegacySplitTimeEntryWithBodyWithResponse request with any body
+	LegacySplitTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacySplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryResponse, error)
+
+	LegacySplitTimeEntryWithResponse(ctx context.Context, params *LegacySplitTimeEntryParams, body LegacySplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryResponse, error)
+
+	// LegacySplitTimeEntryAtMidnightWithResponse request
+	LegacySplitTimeEntryAtMidnightWithResponse(ctx context.Context, params *LegacySplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryAtMidnightResponse, error)
+
+	// LegacySetTimeEntryTagsWithBodyWithResponse request with any body
+	LegacySetTimeEntryTagsWithBodyWithResponse(ctx context.Context, params *LegacySetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryTagsResponse, error)
+
+	LegacySetTimeEntryTagsWithResponse(ctx context.Context, params *LegacySetTimeEntryTagsParams, body LegacySetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryTagsResponse, error)
+
+	// LegacyApproveTimesheetWithBodyWithResponse request with any body
+	LegacyApproveTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyApproveTimesheetResponse, error)
+
+	LegacyApproveTimesheetWithResponse(ctx context.Context, params *LegacyApproveTimesheetParams, body LegacyApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyApproveTimesheetResponse, error)
+
+	// LegacyGetTimesheetPeriodWithResponse request
+	LegacyGetTimesheetPeriodWithResponse(ctx context.Context, params *LegacyGetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*LegacyGetTimesheetPeriodResponse, error)
+
+	// LegacyListTimesheetPeriodsWithResponse request
+	LegacyListTimesheetPeriodsWithResponse(ctx context.Context, params *LegacyListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*LegacyListTimesheetPeriodsResponse, error)
+
+	// LegacyRejectTimesheetWithBodyWithResponse request with any body
+	LegacyRejectTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyRejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyRejectTimesheetResponse, error)
+
+	LegacyRejectTimesheetWithResponse(ctx context.Context, params *LegacyRejectTimesheetParams, body LegacyRejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyRejectTimesheetResponse, error)
+
+	// LegacySubmitTimesheetWithBodyWithResponse request with any body
+	LegacySubmitTimesheetWithBodyWithResponse(ctx context.Context, params *LegacySubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySubmitTimesheetResponse, error)
+
+	LegacySubmitTimesheetWithResponse(ctx context.Context, params *LegacySubmitTimesheetParams, body LegacySubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySubmitTimesheetResponse, error)
+
+	// LegacyGetWeeklyTimesheetWithResponse request
+	LegacyGetWeeklyTimesheetWithResponse(ctx context.Context, params *LegacyGetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*LegacyGetWeeklyTimesheetResponse, error)
+
+	// LegacyUpdateWeeklyTimesheetWithBodyWithResponse request with any body
+	LegacyUpdateWeeklyTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateWeeklyTimesheetResponse, error)
+
+	LegacyUpdateWeeklyTimesheetWithResponse(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, body LegacyUpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateWeeklyTimesheetResponse, error)
+
+	// LegacyListUsersWithResponse request
+	LegacyListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListUsersResponse, error)
+
+	// GetBrandingWithResponse request
+	GetBrandingWithResponse(ctx context.Context, params *GetBrandingParams, reqEditors ...RequestEditorFn) (*GetBrandingResponse, error)
+
+	// UpdateBrandingWithBodyWithResponse request with any body
+	UpdateBrandingWithBodyWithResponse(ctx context.Context, params *UpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBrandingResponse, error)
+
+	UpdateBrandingWithResponse(ctx context.Context, params *UpdateBrandingParams, body UpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBrandingResponse, error)
+
+	// DeleteBrandingLogoWithResponse request
+	DeleteBrandingLogoWithResponse(ctx context.Context, params *DeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*DeleteBrandingLogoResponse, error)
+
+	// GetBrandingLogoWithResponse request
+	GetBrandingLogoWithResponse(ctx context.Context, params *GetBrandingLogoParams, reqEditors ...RequestEditorFn) (*GetBrandingLogoResponse, error)
+
+	// UploadBrandingLogoWithBodyWithResponse request with any body
+	UploadBrandingLogoWithBodyWithResponse(ctx context.Context, params *UploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadBrandingLogoResponse, error)
+
+	// ListClientsWithResponse request
+	ListClientsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClientsResponse, error)
+
+	// CreateClientWithBodyWithResponse request with any body
+	CreateClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientResponse, error)
+
+	CreateClientWithResponse(ctx context.Context, body CreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientResponse, error)
+
+	// GetClientBrandingWithResponse request
+	GetClientBrandingWithResponse(ctx context.Context, clientId ClientID, params *GetClientBrandingParams, reqEditors ...RequestEditorFn) (*GetClientBrandingResponse, error)
+
+	// UpdateClientBrandingWithBodyWithResponse request with any body
+	UpdateClientBrandingWithBodyWithResponse(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientBrandingResponse, error)
+
+	UpdateClientBrandingWithResponse(ctx context.Context, clientId ClientID, body UpdateClientBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientBrandingResponse, error)
+
+	// DeleteClientBrandingLogoWithResponse request
+	DeleteClientBrandingLogoWithResponse(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*DeleteClientBrandingLogoResponse, error)
+
+	// GetClientBrandingLogoWithResponse request
+	GetClientBrandingLogoWithResponse(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*GetClientBrandingLogoResponse, error)
+
+	// UploadClientBrandingLogoWithBodyWithResponse request with any body
+	UploadClientBrandingLogoWithBodyWithResponse(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadClientBrandingLogoResponse, error)
+
+	// DeleteClientWithResponse request
+	DeleteClientWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteClientResponse, error)
+
+	// GetClientWithResponse request
+	GetClientWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetClientResponse, error)
+
+	// UpdateClientWithBodyWithResponse request with any body
+	UpdateClientWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientResponse, error)
+
+	UpdateClientWithResponse(ctx context.Context, id ID, body UpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientResponse, error)
+
+	// ListCurrenciesWithResponse request
+	ListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, params *CreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, params *CreateProjectParams, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, id ID, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// PatchProjectWithBodyWithResponse request with any body
+	PatchProjectWithBodyWithResponse(ctx context.Context, id ID, params *PatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
+
+	PatchProjectWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id ID, params *PatchProjectParams, body PatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error)
+
+	// ReplaceProjectWithBodyWithResponse request with any body
+	ReplaceProjectWithBodyWithResponse(ctx context.Context, id ID, params *ReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceProjectResponse, error)
+
+	ReplaceProjectWithResponse(ctx context.Context, id ID, params *ReplaceProjectParams, body ReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceProjectResponse, error)
+
+	// ListProjectMembersWithResponse request
+	ListProjectMembersWithResponse(ctx context.Context, projectId ProjectID, reqEditors ...RequestEditorFn) (*ListProjectMembersResponse, error)
+
+	// AddProjectMemberWithBodyWithResponse request with any body
+	AddProjectMemberWithBodyWithResponse(ctx context.Context, projectId ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error)
+
+	AddProjectMemberWithResponse(ctx context.Context, projectId ProjectID, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error)
+
+	// RemoveProjectMemberWithResponse request
+	RemoveProjectMemberWithResponse(ctx context.Context, projectId ProjectID, userId MemberUserID, reqEditors ...RequestEditorFn) (*RemoveProjectMemberResponse, error)
+
+	// ListProjectTimeEntriesWithResponse request
+	ListProjectTimeEntriesWithResponse(ctx context.Context, projectId ProjectID, params *ListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*ListProjectTimeEntriesResponse, error)
+
+	// ExportReportWithResponse request
+	ExportReportWithResponse(ctx context.Context, params *ExportReportParams, reqEditors ...RequestEditorFn) (*ExportReportResponse, error)
+
+	// GetPDFReportWithResponse request
+	GetPDFReportWithResponse(ctx context.Context, params *GetPDFReportParams, reqEditors ...RequestEditorFn) (*GetPDFReportResponse, error)
+
+	// GetReportSummaryWithResponse request
+	GetReportSummaryWithResponse(ctx context.Context, params *GetReportSummaryParams, reqEditors ...RequestEditorFn) (*GetReportSummaryResponse, error)
+
+	// GetSettingsWithResponse request
+	GetSettingsWithResponse(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+
+	// PatchSettingsWithBodyWithResponse request with any body
+	PatchSettingsWithBodyWithResponse(ctx context.Context, params *PatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSettingsResponse, error)
+
+	PatchSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *PatchSettingsParams, body PatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSettingsResponse, error)
+
+	// ReplaceSettingsWithBodyWithResponse request with any body
+	ReplaceSettingsWithBodyWithResponse(ctx context.Context, params *ReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceSettingsResponse, error)
+
+	ReplaceSettingsWithResponse(ctx context.Context, params *ReplaceSettingsParams, body ReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceSettingsResponse, error)
+
+	// GetUserSettingsWithResponse request
+	GetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error)
+
+	// PatchUserSettingsWithBodyWithResponse request with any body
+	PatchUserSettingsWithBodyWithResponse(ctx context.Context, params *PatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserSettingsResponse, error)
+
+	PatchUserSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *PatchUserSettingsParams, body PatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserSettingsResponse, error)
+
+	// ReplaceUserSettingsWithBodyWithResponse request with any body
+	ReplaceUserSettingsWithBodyWithResponse(ctx context.Context, params *ReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceUserSettingsResponse, error)
+
+	ReplaceUserSettingsWithResponse(ctx context.Context, params *ReplaceUserSettingsParams, body ReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceUserSettingsResponse, error)
+
+	// ListTagsWithResponse request
+	ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// ListTimeEntriesWithResponse request
+	ListTimeEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTimeEntriesResponse, error)
+
+	// CreateTimeEntryWithBodyWithResponse request with any body
+	CreateTimeEntryWithBodyWithResponse(ctx context.Context, params *CreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimeEntryResponse, error)
+
+	CreateTimeEntryWithResponse(ctx context.Context, params *CreateTimeEntryParams, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimeEntryResponse, error)
+
+	// BulkDeleteTimeEntriesWithBodyWithResponse request with any body
+	BulkDeleteTimeEntriesWithBodyWithResponse(ctx context.Context, params *BulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkDeleteTimeEntriesResponse, error)
+
+	BulkDeleteTimeEntriesWithResponse(ctx context.Context, params *BulkDeleteTimeEntriesParams, body BulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkDeleteTimeEntriesResponse, error)
+
+	// BulkUpdateTimeEntriesWithBodyWithResponse request with any body
+	BulkUpdateTimeEntriesWithBodyWithResponse(ctx context.Context, params *BulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateTimeEntriesResponse, error)
+
+	BulkUpdateTimeEntriesWithResponse(ctx context.Context, params *BulkUpdateTimeEntriesParams, body BulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateTimeEntriesResponse, error)
+
+	// ImportTimeEntriesWithBodyWithResponse request with any body
+	ImportTimeEntriesWithBodyWithResponse(ctx context.Context, params *ImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTimeEntriesResponse, error)
+
+	// MergeTimeEntriesWithBodyWithResponse request with any body
+	MergeTimeEntriesWithBodyWithResponse(ctx context.Context, params *MergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeTimeEntriesResponse, error)
+
+	MergeTimeEntriesWithResponse(ctx context.Context, params *MergeTimeEntriesParams, body MergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeTimeEntriesResponse, error)
+
+	// SplitTimeEntryWithBodyWithResponse request with any body
+	SplitTimeEntryWithBodyWithResponse(ctx context.Context, params *SplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitTimeEntryResponse, error)
+
+	SplitTimeEntryWithResponse(ctx context.Context, params *SplitTimeEntryParams, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitTimeEntryResponse, error)
+
+	// DeleteTimeEntryWithResponse request
+	DeleteTimeEntryWithResponse(ctx context.Context, id ID, params *DeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResponse, error)
+
+	// GetTimeEntryWithResponse request
+	GetTimeEntryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTimeEntryResponse, error)
+
+	// PatchTimeEntryWithBodyWithResponse request with any body
+	PatchTimeEntryWithBodyWithResponse(ctx context.Context, id ID, params *PatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTimeEntryResponse, error)
+
+	PatchTimeEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id ID, params *PatchTimeEntryParams, body PatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTimeEntryResponse, error)
+
+	// ReplaceTimeEntryWithBodyWithResponse request with any body
+	ReplaceTimeEntryWithBodyWithResponse(ctx context.Context, id ID, params *ReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceTimeEntryResponse, error)
+
+	ReplaceTimeEntryWithResponse(ctx context.Context, id ID, params *ReplaceTimeEntryParams, body ReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceTimeEntryResponse, error)
+
+	// SetTimeEntryBillableWithBodyWithResponse request with any body
+	SetTimeEntryBillableWithBodyWithResponse(ctx context.Context, id ID, params *SetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryBillableResponse, error)
+
+	SetTimeEntryBillableWithResponse(ctx context.Context, id ID, params *SetTimeEntryBillableParams, body SetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryBillableResponse, error)
+
+	// GetTimeEntryHistoryWithResponse request
+	GetTimeEntryHistoryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTimeEntryHistoryResponse, error)
+
+	// SplitTimeEntryAtMidnightWithResponse request
+	SplitTimeEntryAtMidnightWithResponse(ctx context.Context, id ID, params *SplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*SplitTimeEntryAtMidnightResponse, error)
+
+	// SetTimeEntryTagsWithBodyWithResponse request with any body
+	SetTimeEntryTagsWithBodyWithResponse(ctx context.Context, id ID, params *SetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResponse, error)
+
+	SetTimeEntryTagsWithResponse(ctx context.Context, id ID, params *SetTimeEntryTagsParams, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResponse, error)
+
+	// ApproveTimesheetWithBodyWithResponse request with any body
+	ApproveTimesheetWithBodyWithResponse(ctx context.Context, params *ApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveTimesheetResponse, error)
+
+	ApproveTimesheetWithResponse(ctx context.Context, params *ApproveTimesheetParams, body ApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveTimesheetResponse, error)
+
+	// GetTimesheetPeriodWithResponse request
+	GetTimesheetPeriodWithResponse(ctx context.Context, params *GetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*GetTimesheetPeriodResponse, error)
+
+	// ListTimesheetPeriodsWithResponse request
+	ListTimesheetPeriodsWithResponse(ctx context.Context, params *ListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*ListTimesheetPeriodsResponse, error)
+
+	// RejectTimesheetWithBodyWithResponse request with any body
+	RejectTimesheetWithBodyWithResponse(ctx context.Context, params *RejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectTimesheetResponse, error)
+
+	RejectTimesheetWithResponse(ctx context.Context, params *RejectTimesheetParams, body RejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectTimesheetResponse, error)
+
+	// SubmitTimesheetWithBodyWithResponse request with any body
+	SubmitTimesheetWithBodyWithResponse(ctx context.Context, params *SubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitTimesheetResponse, error)
+
+	SubmitTimesheetWithResponse(ctx context.Context, params *SubmitTimesheetParams, body SubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitTimesheetResponse, error)
+
+	// GetWeeklyTimesheetWithResponse request
+	GetWeeklyTimesheetWithResponse(ctx context.Context, params *GetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*GetWeeklyTimesheetResponse, error)
+
+	// UpdateWeeklyTimesheetWithBodyWithResponse request with any body
+	UpdateWeeklyTimesheetWithBodyWithResponse(ctx context.Context, params *UpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWeeklyTimesheetResponse, error)
+
+	UpdateWeeklyTimesheetWithResponse(ctx context.Context, params *UpdateWeeklyTimesheetParams, body UpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWeeklyTimesheetResponse, error)
+
+	// ListUsersWithResponse request
+	ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+
+	// ListWorkspacesWithResponse request
+	ListWorkspacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error)
+
+	// CreateWorkspaceWithBodyWithResponse request with any body
+	CreateWorkspaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkspaceResponse, error)
+
+	CreateWorkspaceWithResponse(ctx context.Context, body CreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkspaceResponse, error)
+
+	// ListWorkspaceMembersWithResponse request
+	ListWorkspaceMembersWithResponse(ctx context.Context, workspaceId WorkspaceID, reqEditors ...RequestEditorFn) (*ListWorkspaceMembersResponse, error)
+
+	// AddWorkspaceMemberWithBodyWithResponse request with any body
+	AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, workspaceId WorkspaceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+
+	AddWorkspaceMemberWithResponse(ctx context.Context, workspaceId WorkspaceID, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+
+	// RemoveWorkspaceMemberWithResponse request
+	RemoveWorkspaceMemberWithResponse(ctx context.Context, workspaceId WorkspaceID, userId MemberUserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error)
+
+	// LegacyListWorkspacesWithResponse request
+	LegacyListWorkspacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListWorkspacesResponse, error)
+
+	// LegacyCreateWorkspaceWithBodyWithResponse request with any body
+	LegacyCreateWorkspaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateWorkspaceResponse, error)
+
+	LegacyCreateWorkspaceWithResponse(ctx context.Context, body LegacyCreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateWorkspaceResponse, error)
+
+	// LegacyRemoveWorkspaceMemberWithResponse request
+	LegacyRemoveWorkspaceMemberWithResponse(ctx context.Context, params *LegacyRemoveWorkspaceMemberParams, reqEditors ...RequestEditorFn) (*LegacyRemoveWorkspaceMemberResponse, error)
+
+	// LegacyListWorkspaceMembersWithResponse request
+	LegacyListWorkspaceMembersWithResponse(ctx context.Context, params *LegacyListWorkspaceMembersParams, reqEditors ...RequestEditorFn) (*LegacyListWorkspaceMembersResponse, error)
+
+	// LegacyAddWorkspaceMemberWithBodyWithResponse request with any body
+	LegacyAddWorkspaceMemberWithBodyWithResponse(ctx context.Context, params *LegacyAddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyAddWorkspaceMemberResponse, error)
+
+	LegacyAddWorkspaceMemberWithResponse(ctx context.Context, params *LegacyAddWorkspaceMemberParams, body LegacyAddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyAddWorkspaceMemberResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+}
+
+type LegacyGetBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyUpdateBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyUpdateBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyUpdateBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyDeleteBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyDeleteBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyDeleteBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyUploadBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogoUploadResult
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyUploadBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyUploadBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Client
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyCreateClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyCreateClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyCreateClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyDeleteClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyDeleteClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyDeleteClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyUpdateClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyUpdateClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyUpdateClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListCurrenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Currency
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Project
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyCreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyCreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyCreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyRemoveProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyRemoveProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyRemoveProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListProjectMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectMember
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListProjectMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListProjectMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyAddProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectMember
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyAddProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyAddProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyDeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyDeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyDeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyPatchProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyPatchProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyPatchProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyReplaceProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyReplaceProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyReplaceProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyExportReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyExportReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyExportReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetPDFReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetPDFReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetPDFReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetReportSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Summary
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetReportSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetReportSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyPatchSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyPatchSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyPatchSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyReplaceSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyReplaceSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyReplaceSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyPatchUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyPatchUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyPatchUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyReplaceUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyReplaceUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyReplaceUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyCreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyCreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyCreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyCreateTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyCreateTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyCreateTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacySetTimeEntryBillableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BillableResult
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacySetTimeEntryBillableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacySetTimeEntryBillableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyBulkDeleteTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyBulkDeleteTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyBulkDeleteTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyBulkUpdateTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyBulkUpdateTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyBulkUpdateTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetTimeEntryHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntryHistory
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetTimeEntryHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetTimeEntryHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyImportTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyImportTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyImportTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyMergeTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyMergeTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyMergeTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListProjectTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListProjectTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListProjectTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyDeleteTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyDeleteTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyDeleteTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyPatchTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyPatchTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyPatchTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyReplaceTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyReplaceTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyReplaceTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacySplitTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacySplitTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacySplitTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacySplitTimeEntryAtMidnightResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacySplitTimeEntryAtMidnightResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacySplitTimeEntryAtMidnightResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacySetTimeEntryTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsResult
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacySetTimeEntryTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacySetTimeEntryTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyApproveTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyApproveTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyApproveTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetTimesheetPeriodResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetTimesheetPeriodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetTimesheetPeriodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListTimesheetPeriodsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListTimesheetPeriodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListTimesheetPeriodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyRejectTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyRejectTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyRejectTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacySubmitTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacySubmitTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacySubmitTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyGetWeeklyTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timesheet
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyGetWeeklyTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyGetWeeklyTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyUpdateWeeklyTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timesheet
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyUpdateWeeklyTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyUpdateWeeklyTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogoUploadResult
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Client
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClientBrandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Branding
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClientBrandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClientBrandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClientBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClientBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadClientBrandingLogoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogoUploadResult
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadClientBrandingLogoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadClientBrandingLogoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Client
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCurrenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Currency
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Project
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectMember
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectMember
+}
+
+// Status returns HTTPResponse.Status
+func (r AddProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveProjectMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveProjectMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveProjectMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPDFReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPDFReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPDFReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Summary
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkDeleteTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkDeleteTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkDeleteTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkUpdateTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkUpdateTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkUpdateTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergeTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r MergeTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergeTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SplitTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r SplitTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SplitTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTimeEntryBillableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BillableResult
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTimeEntryBillableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTimeEntryBillableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeEntryHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntryHistory
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeEntryHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeEntryHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SplitTimeEntryAtMidnightResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r SplitTimeEntryAtMidnightResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SplitTimeEntryAtMidnightResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTimeEntryTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsResult
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTimeEntryTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTimeEntryTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimesheetPeriodResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimesheetPeriodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimesheetPeriodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTimesheetPeriodsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTimesheetPeriodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTimesheetPeriodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimesheetPeriod
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWeeklyTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timesheet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWeeklyTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWeeklyTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWeeklyTimesheetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timesheet
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWeeklyTimesheetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWeeklyTimesheetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWorkspacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkspacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkspacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWorkspaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWorkspaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWorkspaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWorkspaceMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WorkspaceMember
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkspaceMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkspaceMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkspaceMember
+}
+
+// Status returns HTTPResponse.Status
+func (r AddWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListWorkspacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListWorkspacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListWorkspacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyCreateWorkspaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyCreateWorkspaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyCreateWorkspaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyRemoveWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyRemoveWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyRemoveWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyListWorkspaceMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WorkspaceMember
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyListWorkspaceMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyListWorkspaceMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LegacyAddWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkspaceMember
+}
+
+// Status returns HTTPResponse.Status
+func (r LegacyAddWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LegacyAddWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// LegacyGetBrandingWithResponse request returning *LegacyGetBrandingResponse
+func (c *ClientWithResponses) LegacyGetBrandingWithResponse(ctx context.Context, params *LegacyGetBrandingParams, reqEditors ...RequestEditorFn) (*LegacyGetBrandingResponse, error) {
+	rsp, err := c.LegacyGetBranding(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetBrandingResponse(rsp)
+}
+
+// LegacyUpdateBrandingWithBodyWithResponse request with arbitrary body returning *LegacyUpdateBrandingResponse
+func (c *ClientWithResponses) LegacyUpdateBrandingWithBodyWithResponse(ctx context.Context, params *LegacyUpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateBrandingResponse, error) {
+	rsp, err := c.LegacyUpdateBrandingWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateBrandingResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyUpdateBrandingWithResponse(ctx context.Context, params *LegacyUpdateBrandingParams, body LegacyUpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateBrandingResponse, error) {
+	rsp, err := c.LegacyUpdateBranding(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateBrandingResponse(rsp)
+}
+
+// LegacyDeleteBrandingLogoWithResponse request returning *LegacyDeleteBrandingLogoResponse
+func (c *ClientWithResponses) LegacyDeleteBrandingLogoWithResponse(ctx context.Context, params *LegacyDeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*LegacyDeleteBrandingLogoResponse, error) {
+	rsp, err := c.LegacyDeleteBrandingLogo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyDeleteBrandingLogoResponse(rsp)
+}
+
+// LegacyGetBrandingLogoWithResponse request returning *LegacyGetBrandingLogoResponse
+func (c *ClientWithResponses) LegacyGetBrandingLogoWithResponse(ctx context.Context, params *LegacyGetBrandingLogoParams, reqEditors ...RequestEditorFn) (*LegacyGetBrandingLogoResponse, error) {
+	rsp, err := c.LegacyGetBrandingLogo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetBrandingLogoResponse(rsp)
+}
+
+// LegacyUploadBrandingLogoWithBodyWithResponse request with arbitrary body returning *LegacyUploadBrandingLogoResponse
+func (c *ClientWithResponses) LegacyUploadBrandingLogoWithBodyWithResponse(ctx context.Context, params *LegacyUploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUploadBrandingLogoResponse, error) {
+	rsp, err := c.LegacyUploadBrandingLogoWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUploadBrandingLogoResponse(rsp)
+}
+
+// LegacyListClientsWithResponse request returning *LegacyListClientsResponse
+func (c *ClientWithResponses) LegacyListClientsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListClientsResponse, error) {
+	rsp, err := c.LegacyListClients(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListClientsResponse(rsp)
+}
+
+// LegacyCreateClientWithBodyWithResponse request with arbitrary body returning *LegacyCreateClientResponse
+func (c *ClientWithResponses) LegacyCreateClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateClientResponse, error) {
+	rsp, err := c.LegacyCreateClientWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyCreateClientWithResponse(ctx context.Context, body LegacyCreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateClientResponse, error) {
+	rsp, err := c.LegacyCreateClient(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateClientResponse(rsp)
+}
+
+// LegacyDeleteClientWithResponse request returning *LegacyDeleteClientResponse
+func (c *ClientWithResponses) LegacyDeleteClientWithResponse(ctx context.Context, params *LegacyDeleteClientParams, reqEditors ...RequestEditorFn) (*LegacyDeleteClientResponse, error) {
+	rsp, err := c.LegacyDeleteClient(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyDeleteClientResponse(rsp)
+}
+
+// LegacyGetClientWithResponse request returning *LegacyGetClientResponse
+func (c *ClientWithResponses) LegacyGetClientWithResponse(ctx context.Context, params *LegacyGetClientParams, reqEditors ...RequestEditorFn) (*LegacyGetClientResponse, error) {
+	rsp, err := c.LegacyGetClient(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetClientResponse(rsp)
+}
+
+// LegacyUpdateClientWithBodyWithResponse request with arbitrary body returning *LegacyUpdateClientResponse
+func (c *ClientWithResponses) LegacyUpdateClientWithBodyWithResponse(ctx context.Context, params *LegacyUpdateClientParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateClientResponse, error) {
+	rsp, err := c.LegacyUpdateClientWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyUpdateClientWithResponse(ctx context.Context, params *LegacyUpdateClientParams, body LegacyUpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateClientResponse, error) {
+	rsp, err := c.LegacyUpdateClient(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateClientResponse(rsp)
+}
+
+// LegacyListCurrenciesWithResponse request returning *LegacyListCurrenciesResponse
+func (c *ClientWithResponses) LegacyListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListCurrenciesResponse, error) {
+	rsp, err := c.LegacyListCurrencies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListCurrenciesResponse(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResponse
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResponse, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResponse(rsp)
+}
+
+// LegacyListProjectsWithResponse request returning *LegacyListProjectsResponse
+func (c *ClientWithResponses) LegacyListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListProjectsResponse, error) {
+	rsp, err := c.LegacyListProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListProjectsResponse(rsp)
+}
+
+// LegacyCreateProjectWithBodyWithResponse request with arbitrary body returning *LegacyCreateProjectResponse
+func (c *ClientWithResponses) LegacyCreateProjectWithBodyWithResponse(ctx context.Context, params *LegacyCreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateProjectResponse, error) {
+	rsp, err := c.LegacyCreateProjectWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyCreateProjectWithResponse(ctx context.Context, params *LegacyCreateProjectParams, body LegacyCreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateProjectResponse, error) {
+	rsp, err := c.LegacyCreateProject(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateProjectResponse(rsp)
+}
+
+// LegacyRemoveProjectMemberWithResponse request returning *LegacyRemoveProjectMemberResponse
+func (c *ClientWithResponses) LegacyRemoveProjectMemberWithResponse(ctx context.Context, params *LegacyRemoveProjectMemberParams, reqEditors ...RequestEditorFn) (*LegacyRemoveProjectMemberResponse, error) {
+	rsp, err := c.LegacyRemoveProjectMember(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyRemoveProjectMemberResponse(rsp)
+}
+
+// LegacyListProjectMembersWithResponse request returning *LegacyListProjectMembersResponse
+func (c *ClientWithResponses) LegacyListProjectMembersWithResponse(ctx context.Context, params *LegacyListProjectMembersParams, reqEditors ...RequestEditorFn) (*LegacyListProjectMembersResponse, error) {
+	rsp, err := c.LegacyListProjectMembers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListProjectMembersResponse(rsp)
+}
+
+// LegacyAddProjectMemberWithBodyWithResponse request with arbitrary body returning *LegacyAddProjectMemberResponse
+func (c *ClientWithResponses) LegacyAddProjectMemberWithBodyWithResponse(ctx context.Context, params *LegacyAddProjectMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyAddProjectMemberResponse, error) {
+	rsp, err := c.LegacyAddProjectMemberWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyAddProjectMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyAddProjectMemberWithResponse(ctx context.Context, params *LegacyAddProjectMemberParams, body LegacyAddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyAddProjectMemberResponse, error) {
+	rsp, err := c.LegacyAddProjectMember(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyAddProjectMemberResponse(rsp)
+}
+
+// LegacyDeleteProjectWithResponse request returning *LegacyDeleteProjectResponse
+func (c *ClientWithResponses) LegacyDeleteProjectWithResponse(ctx context.Context, params *LegacyDeleteProjectParams, reqEditors ...RequestEditorFn) (*LegacyDeleteProjectResponse, error) {
+	rsp, err := c.LegacyDeleteProject(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyDeleteProjectResponse(rsp)
+}
+
+// LegacyGetProjectWithResponse request returning *LegacyGetProjectResponse
+func (c *ClientWithResponses) LegacyGetProjectWithResponse(ctx context.Context, params *LegacyGetProjectParams, reqEditors ...RequestEditorFn) (*LegacyGetProjectResponse, error) {
+	rsp, err := c.LegacyGetProject(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetProjectResponse(rsp)
+}
+
+// LegacyPatchProjectWithBodyWithResponse request with arbitrary body returning *LegacyPatchProjectResponse
+func (c *ClientWithResponses) LegacyPatchProjectWithBodyWithResponse(ctx context.Context, params *LegacyPatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchProjectResponse, error) {
+	rsp, err := c.LegacyPatchProjectWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyPatchProjectWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchProjectParams, body LegacyPatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchProjectResponse, error) {
+	rsp, err := c.LegacyPatchProjectWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchProjectResponse(rsp)
+}
+
+// LegacyReplaceProjectWithBodyWithResponse request with arbitrary body returning *LegacyReplaceProjectResponse
+func (c *ClientWithResponses) LegacyReplaceProjectWithBodyWithResponse(ctx context.Context, params *LegacyReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceProjectResponse, error) {
+	rsp, err := c.LegacyReplaceProjectWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyReplaceProjectWithResponse(ctx context.Context, params *LegacyReplaceProjectParams, body LegacyReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceProjectResponse, error) {
+	rsp, err := c.LegacyReplaceProject(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceProjectResponse(rsp)
+}
+
+// LegacyExportReportWithResponse request returning *LegacyExportReportResponse
+func (c *ClientWithResponses) LegacyExportReportWithResponse(ctx context.Context, params *LegacyExportReportParams, reqEditors ...RequestEditorFn) (*LegacyExportReportResponse, error) {
+	rsp, err := c.LegacyExportReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyExportReportResponse(rsp)
+}
+
+// LegacyGetPDFReportWithResponse request returning *LegacyGetPDFReportResponse
+func (c *ClientWithResponses) LegacyGetPDFReportWithResponse(ctx context.Context, params *LegacyGetPDFReportParams, reqEditors ...RequestEditorFn) (*LegacyGetPDFReportResponse, error) {
+	rsp, err := c.LegacyGetPDFReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetPDFReportResponse(rsp)
+}
+
+// LegacyGetReportSummaryWithResponse request returning *LegacyGetReportSummaryResponse
+func (c *ClientWithResponses) LegacyGetReportSummaryWithResponse(ctx context.Context, params *LegacyGetReportSummaryParams, reqEditors ...RequestEditorFn) (*LegacyGetReportSummaryResponse, error) {
+	rsp, err := c.LegacyGetReportSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetReportSummaryResponse(rsp)
+}
+
+// LegacyGetSettingsWithResponse request returning *LegacyGetSettingsResponse
+func (c *ClientWithResponses) LegacyGetSettingsWithResponse(ctx context.Context, params *LegacyGetSettingsParams, reqEditors ...RequestEditorFn) (*LegacyGetSettingsResponse, error) {
+	rsp, err := c.LegacyGetSettings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetSettingsResponse(rsp)
+}
+
+// LegacyPatchSettingsWithBodyWithResponse request with arbitrary body returning *LegacyPatchSettingsResponse
+func (c *ClientWithResponses) LegacyPatchSettingsWithBodyWithResponse(ctx context.Context, params *LegacyPatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchSettingsResponse, error) {
+	rsp, err := c.LegacyPatchSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyPatchSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchSettingsParams, body LegacyPatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchSettingsResponse, error) {
+	rsp, err := c.LegacyPatchSettingsWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchSettingsResponse(rsp)
+}
+
+// LegacyReplaceSettingsWithBodyWithResponse request with arbitrary body returning *LegacyReplaceSettingsResponse
+func (c *ClientWithResponses) LegacyReplaceSettingsWithBodyWithResponse(ctx context.Context, params *LegacyReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceSettingsResponse, error) {
+	rsp, err := c.LegacyReplaceSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyReplaceSettingsWithResponse(ctx context.Context, params *LegacyReplaceSettingsParams, body LegacyReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceSettingsResponse, error) {
+	rsp, err := c.LegacyReplaceSettings(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceSettingsResponse(rsp)
+}
+
+// LegacyGetUserSettingsWithResponse request returning *LegacyGetUserSettingsResponse
+func (c *ClientWithResponses) LegacyGetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyGetUserSettingsResponse, error) {
+	rsp, err := c.LegacyGetUserSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetUserSettingsResponse(rsp)
+}
+
+// LegacyPatchUserSettingsWithBodyWithResponse request with arbitrary body returning *LegacyPatchUserSettingsResponse
+func (c *ClientWithResponses) LegacyPatchUserSettingsWithBodyWithResponse(ctx context.Context, params *LegacyPatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchUserSettingsResponse, error) {
+	rsp, err := c.LegacyPatchUserSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyPatchUserSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchUserSettingsParams, body LegacyPatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchUserSettingsResponse, error) {
+	rsp, err := c.LegacyPatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchUserSettingsResponse(rsp)
+}
+
+// LegacyReplaceUserSettingsWithBodyWithResponse request with arbitrary body returning *LegacyReplaceUserSettingsResponse
+func (c *ClientWithResponses) LegacyReplaceUserSettingsWithBodyWithResponse(ctx context.Context, params *LegacyReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceUserSettingsResponse, error) {
+	rsp, err := c.LegacyReplaceUserSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyReplaceUserSettingsWithResponse(ctx context.Context, params *LegacyReplaceUserSettingsParams, body LegacyReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceUserSettingsResponse, error) {
+	rsp, err := c.LegacyReplaceUserSettings(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceUserSettingsResponse(rsp)
+}
+
+// LegacyListTagsWithResponse request returning *LegacyListTagsResponse
+func (c *ClientWithResponses) LegacyListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListTagsResponse, error) {
+	rsp, err := c.LegacyListTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListTagsResponse(rsp)
+}
+
+// LegacyCreateTagWithBodyWithResponse request with arbitrary body returning *LegacyCreateTagResponse
+func (c *ClientWithResponses) LegacyCreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateTagResponse, error) {
+	rsp, err := c.LegacyCreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyCreateTagWithResponse(ctx context.Context, body LegacyCreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateTagResponse, error) {
+	rsp, err := c.LegacyCreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateTagResponse(rsp)
+}
+
+// LegacyListTimeEntriesWithResponse request returning *LegacyListTimeEntriesResponse
+func (c *ClientWithResponses) LegacyListTimeEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListTimeEntriesResponse, error) {
+	rsp, err := c.LegacyListTimeEntries(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListTimeEntriesResponse(rsp)
+}
+
+// LegacyCreateTimeEntryWithBodyWithResponse request with arbitrary body returning *LegacyCreateTimeEntryResponse
+func (c *ClientWithResponses) LegacyCreateTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyCreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateTimeEntryResponse, error) {
+	rsp, err := c.LegacyCreateTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyCreateTimeEntryWithResponse(ctx context.Context, params *LegacyCreateTimeEntryParams, body LegacyCreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateTimeEntryResponse, error) {
+	rsp, err := c.LegacyCreateTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateTimeEntryResponse(rsp)
+}
+
+// LegacySetTimeEntryBillableWithBodyWithResponse request with arbitrary body returning *LegacySetTimeEntryBillableResponse
+func (c *ClientWithResponses) LegacySetTimeEntryBillableWithBodyWithResponse(ctx context.Context, params *LegacySetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryBillableResponse, error) {
+	rsp, err := c.LegacySetTimeEntryBillableWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySetTimeEntryBillableResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacySetTimeEntryBillableWithResponse(ctx context.Context, params *LegacySetTimeEntryBillableParams, body LegacySetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryBillableResponse, error) {
+	rsp, err := c.LegacySetTimeEntryBillable(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySetTimeEntryBillableResponse(rsp)
+}
+
+// LegacyBulkDeleteTimeEntriesWithBodyWithResponse request with arbitrary body returning *LegacyBulkDeleteTimeEntriesResponse
+func (c *ClientWithResponses) LegacyBulkDeleteTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyBulkDeleteTimeEntriesResponse, error) {
+	rsp, err := c.LegacyBulkDeleteTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyBulkDeleteTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyBulkDeleteTimeEntriesWithResponse(ctx context.Context, params *LegacyBulkDeleteTimeEntriesParams, body LegacyBulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyBulkDeleteTimeEntriesResponse, error) {
+	rsp, err := c.LegacyBulkDeleteTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyBulkDeleteTimeEntriesResponse(rsp)
+}
+
+// LegacyBulkUpdateTimeEntriesWithBodyWithResponse request with arbitrary body returning *LegacyBulkUpdateTimeEntriesResponse
+func (c *ClientWithResponses) LegacyBulkUpdateTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyBulkUpdateTimeEntriesResponse, error) {
+	rsp, err := c.LegacyBulkUpdateTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyBulkUpdateTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyBulkUpdateTimeEntriesWithResponse(ctx context.Context, params *LegacyBulkUpdateTimeEntriesParams, body LegacyBulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyBulkUpdateTimeEntriesResponse, error) {
+	rsp, err := c.LegacyBulkUpdateTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyBulkUpdateTimeEntriesResponse(rsp)
+}
+
+// LegacyGetTimeEntryHistoryWithResponse request returning *LegacyGetTimeEntryHistoryResponse
+func (c *ClientWithResponses) LegacyGetTimeEntryHistoryWithResponse(ctx context.Context, params *LegacyGetTimeEntryHistoryParams, reqEditors ...RequestEditorFn) (*LegacyGetTimeEntryHistoryResponse, error) {
+	rsp, err := c.LegacyGetTimeEntryHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetTimeEntryHistoryResponse(rsp)
+}
+
+// LegacyImportTimeEntriesWithBodyWithResponse request with arbitrary body returning *LegacyImportTimeEntriesResponse
+func (c *ClientWithResponses) LegacyImportTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyImportTimeEntriesResponse, error) {
+	rsp, err := c.LegacyImportTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyImportTimeEntriesResponse(rsp)
+}
+
+// LegacyMergeTimeEntriesWithBodyWithResponse request with arbitrary body returning *LegacyMergeTimeEntriesResponse
+func (c *ClientWithResponses) LegacyMergeTimeEntriesWithBodyWithResponse(ctx context.Context, params *LegacyMergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyMergeTimeEntriesResponse, error) {
+	rsp, err := c.LegacyMergeTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyMergeTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyMergeTimeEntriesWithResponse(ctx context.Context, params *LegacyMergeTimeEntriesParams, body LegacyMergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyMergeTimeEntriesResponse, error) {
+	rsp, err := c.LegacyMergeTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyMergeTimeEntriesResponse(rsp)
+}
+
+// LegacyListProjectTimeEntriesWithResponse request returning *LegacyListProjectTimeEntriesResponse
+func (c *ClientWithResponses) LegacyListProjectTimeEntriesWithResponse(ctx context.Context, params *LegacyListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*LegacyListProjectTimeEntriesResponse, error) {
+	rsp, err := c.LegacyListProjectTimeEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListProjectTimeEntriesResponse(rsp)
+}
+
+// LegacyDeleteTimeEntryWithResponse request returning *LegacyDeleteTimeEntryResponse
+func (c *ClientWithResponses) LegacyDeleteTimeEntryWithResponse(ctx context.Context, params *LegacyDeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*LegacyDeleteTimeEntryResponse, error) {
+	rsp, err := c.LegacyDeleteTimeEntry(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyDeleteTimeEntryResponse(rsp)
+}
+
+// LegacyGetTimeEntryWithResponse request returning *LegacyGetTimeEntryResponse
+func (c *ClientWithResponses) LegacyGetTimeEntryWithResponse(ctx context.Context, params *LegacyGetTimeEntryParams, reqEditors ...RequestEditorFn) (*LegacyGetTimeEntryResponse, error) {
+	rsp, err := c.LegacyGetTimeEntry(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetTimeEntryResponse(rsp)
+}
+
+// LegacyPatchTimeEntryWithBodyWithResponse request with arbitrary body returning *LegacyPatchTimeEntryResponse
+func (c *ClientWithResponses) LegacyPatchTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyPatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyPatchTimeEntryResponse, error) {
+	rsp, err := c.LegacyPatchTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyPatchTimeEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *LegacyPatchTimeEntryParams, body LegacyPatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyPatchTimeEntryResponse, error) {
+	rsp, err := c.LegacyPatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyPatchTimeEntryResponse(rsp)
+}
+
+// LegacyReplaceTimeEntryWithBodyWithResponse request with arbitrary body returning *LegacyReplaceTimeEntryResponse
+func (c *ClientWithResponses) LegacyReplaceTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacyReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyReplaceTimeEntryResponse, error) {
+	rsp, err := c.LegacyReplaceTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyReplaceTimeEntryWithResponse(ctx context.Context, params *LegacyReplaceTimeEntryParams, body LegacyReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyReplaceTimeEntryResponse, error) {
+	rsp, err := c.LegacyReplaceTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyReplaceTimeEntryResponse(rsp)
+}
+
+// LegacySplitTimeEntryWithBodyWithResponse request with arbitrary body returning *LegacySplitTimeEntryResponse
+func (c *ClientWithResponses) LegacySplitTimeEntryWithBodyWithResponse(ctx context.Context, params *LegacySplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryResponse, error) {
+	rsp, err := c.LegacySplitTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySplitTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacySplitTimeEntryWithResponse(ctx context.Context, params *LegacySplitTimeEntryParams, body LegacySplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryResponse, error) {
+	rsp, err := c.LegacySplitTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySplitTimeEntryResponse(rsp)
+}
+
+// LegacySplitTimeEntryAtMidnightWithResponse request returning *LegacySplitTimeEntryAtMidnightResponse
+func (c *ClientWithResponses) LegacySplitTimeEntryAtMidnightWithResponse(ctx context.Context, params *LegacySplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*LegacySplitTimeEntryAtMidnightResponse, error) {
+	rsp, err := c.LegacySplitTimeEntryAtMidnight(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySplitTimeEntryAtMidnightResponse(rsp)
+}
+
+// LegacySetTimeEntryTagsWithBodyWithResponse request with arbitrary body returning *LegacySetTimeEntryTagsResponse
+func (c *ClientWithResponses) LegacySetTimeEntryTagsWithBodyWithResponse(ctx context.Context, params *LegacySetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryTagsResponse, error) {
+	rsp, err := c.LegacySetTimeEntryTagsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySetTimeEntryTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacySetTimeEntryTagsWithResponse(ctx context.Context, params *LegacySetTimeEntryTagsParams, body LegacySetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySetTimeEntryTagsResponse, error) {
+	rsp, err := c.LegacySetTimeEntryTags(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySetTimeEntryTagsResponse(rsp)
+}
+
+// LegacyApproveTimesheetWithBodyWithResponse request with arbitrary body returning *LegacyApproveTimesheetResponse
+func (c *ClientWithResponses) LegacyApproveTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyApproveTimesheetResponse, error) {
+	rsp, err := c.LegacyApproveTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyApproveTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyApproveTimesheetWithResponse(ctx context.Context, params *LegacyApproveTimesheetParams, body LegacyApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyApproveTimesheetResponse, error) {
+	rsp, err := c.LegacyApproveTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyApproveTimesheetResponse(rsp)
+}
+
+// LegacyGetTimesheetPeriodWithResponse request returning *LegacyGetTimesheetPeriodResponse
+func (c *ClientWithResponses) LegacyGetTimesheetPeriodWithResponse(ctx context.Context, params *LegacyGetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*LegacyGetTimesheetPeriodResponse, error) {
+	rsp, err := c.LegacyGetTimesheetPeriod(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetTimesheetPeriodResponse(rsp)
+}
+
+// LegacyListTimesheetPeriodsWithResponse request returning *LegacyListTimesheetPeriodsResponse
+func (c *ClientWithResponses) LegacyListTimesheetPeriodsWithResponse(ctx context.Context, params *LegacyListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*LegacyListTimesheetPeriodsResponse, error) {
+	rsp, err := c.LegacyListTimesheetPeriods(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListTimesheetPeriodsResponse(rsp)
+}
+
+// LegacyRejectTimesheetWithBodyWithResponse request with arbitrary body returning *LegacyRejectTimesheetResponse
+func (c *ClientWithResponses) LegacyRejectTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyRejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyRejectTimesheetResponse, error) {
+	rsp, err := c.LegacyRejectTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyRejectTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyRejectTimesheetWithResponse(ctx context.Context, params *LegacyRejectTimesheetParams, body LegacyRejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyRejectTimesheetResponse, error) {
+	rsp, err := c.LegacyRejectTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyRejectTimesheetResponse(rsp)
+}
+
+// LegacySubmitTimesheetWithBodyWithResponse request with arbitrary body returning *LegacySubmitTimesheetResponse
+func (c *ClientWithResponses) LegacySubmitTimesheetWithBodyWithResponse(ctx context.Context, params *LegacySubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacySubmitTimesheetResponse, error) {
+	rsp, err := c.LegacySubmitTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySubmitTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacySubmitTimesheetWithResponse(ctx context.Context, params *LegacySubmitTimesheetParams, body LegacySubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacySubmitTimesheetResponse, error) {
+	rsp, err := c.LegacySubmitTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacySubmitTimesheetResponse(rsp)
+}
+
+// LegacyGetWeeklyTimesheetWithResponse request returning *LegacyGetWeeklyTimesheetResponse
+func (c *ClientWithResponses) LegacyGetWeeklyTimesheetWithResponse(ctx context.Context, params *LegacyGetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*LegacyGetWeeklyTimesheetResponse, error) {
+	rsp, err := c.LegacyGetWeeklyTimesheet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyGetWeeklyTimesheetResponse(rsp)
+}
+
+// LegacyUpdateWeeklyTimesheetWithBodyWithResponse request with arbitrary body returning *LegacyUpdateWeeklyTimesheetResponse
+func (c *ClientWithResponses) LegacyUpdateWeeklyTimesheetWithBodyWithResponse(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyUpdateWeeklyTimesheetResponse, error) {
+	rsp, err := c.LegacyUpdateWeeklyTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateWeeklyTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyUpdateWeeklyTimesheetWithResponse(ctx context.Context, params *LegacyUpdateWeeklyTimesheetParams, body LegacyUpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyUpdateWeeklyTimesheetResponse, error) {
+	rsp, err := c.LegacyUpdateWeeklyTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyUpdateWeeklyTimesheetResponse(rsp)
+}
+
+// LegacyListUsersWithResponse request returning *LegacyListUsersResponse
+func (c *ClientWithResponses) LegacyListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListUsersResponse, error) {
+	rsp, err := c.LegacyListUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListUsersResponse(rsp)
+}
+
+// GetBrandingWithResponse request returning *GetBrandingResponse
+func (c *ClientWithResponses) GetBrandingWithResponse(ctx context.Context, params *GetBrandingParams, reqEditors ...RequestEditorFn) (*GetBrandingResponse, error) {
+	rsp, err := c.GetBranding(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBrandingResponse(rsp)
+}
+
+// UpdateBrandingWithBodyWithResponse request with arbitrary body returning *UpdateBrandingResponse
+func (c *ClientWithResponses) UpdateBrandingWithBodyWithResponse(ctx context.Context, params *UpdateBrandingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBrandingResponse, error) {
+	rsp, err := c.UpdateBrandingWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBrandingResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBrandingWithResponse(ctx context.Context, params *UpdateBrandingParams, body UpdateBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBrandingResponse, error) {
+	rsp, err := c.UpdateBranding(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBrandingResponse(rsp)
+}
+
+// DeleteBrandingLogoWithResponse request returning *DeleteBrandingLogoResponse
+func (c *ClientWithResponses) DeleteBrandingLogoWithResponse(ctx context.Context, params *DeleteBrandingLogoParams, reqEditors ...RequestEditorFn) (*DeleteBrandingLogoResponse, error) {
+	rsp, err := c.DeleteBrandingLogo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBrandingLogoResponse(rsp)
+}
+
+// GetBrandingLogoWithResponse request returning *GetBrandingLogoResponse
+func (c *ClientWithResponses) GetBrandingLogoWithResponse(ctx context.Context, params *GetBrandingLogoParams, reqEditors ...RequestEditorFn) (*GetBrandingLogoResponse, error) {
+	rsp, err := c.GetBrandingLogo(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBrandingLogoResponse(rsp)
+}
+
+// UploadBrandingLogoWithBodyWithResponse request with arbitrary body returning *UploadBrandingLogoResponse
+func (c *ClientWithResponses) UploadBrandingLogoWithBodyWithResponse(ctx context.Context, params *UploadBrandingLogoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadBrandingLogoResponse, error) {
+	rsp, err := c.UploadBrandingLogoWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadBrandingLogoResponse(rsp)
+}
+
+// ListClientsWithResponse request returning *ListClientsResponse
+func (c *ClientWithResponses) ListClientsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClientsResponse, error) {
+	rsp, err := c.ListClients(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientsResponse(rsp)
+}
+
+// CreateClientWithBodyWithResponse request with arbitrary body returning *CreateClientResponse
+func (c *ClientWithResponses) CreateClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientResponse, error) {
+	rsp, err := c.CreateClientWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClientWithResponse(ctx context.Context, body CreateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientResponse, error) {
+	rsp, err := c.CreateClient(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientResponse(rsp)
+}
+
+// GetClientBrandingWithResponse request returning *GetClientBrandingResponse
+func (c *ClientWithResponses) GetClientBrandingWithResponse(ctx context.Context, clientId ClientID, params *GetClientBrandingParams, reqEditors ...RequestEditorFn) (*GetClientBrandingResponse, error) {
+	rsp, err := c.GetClientBranding(ctx, clientId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientBrandingResponse(rsp)
+}
+
+// UpdateClientBrandingWithBodyWithResponse request with arbitrary body returning *UpdateClientBrandingResponse
+func (c *ClientWithResponses) UpdateClientBrandingWithBodyWithResponse(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientBrandingResponse, error) {
+	rsp, err := c.UpdateClientBrandingWithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientBrandingResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClientBrandingWithResponse(ctx context.Context, clientId ClientID, body UpdateClientBrandingJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientBrandingResponse, error) {
+	rsp, err := c.UpdateClientBranding(ctx, clientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientBrandingResponse(rsp)
+}
+
+// DeleteClientBrandingLogoWithResponse request returning *DeleteClientBrandingLogoResponse
+func (c *ClientWithResponses) DeleteClientBrandingLogoWithResponse(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*DeleteClientBrandingLogoResponse, error) {
+	rsp, err := c.DeleteClientBrandingLogo(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientBrandingLogoResponse(rsp)
+}
+
+// GetClientBrandingLogoWithResponse request returning *GetClientBrandingLogoResponse
+func (c *ClientWithResponses) GetClientBrandingLogoWithResponse(ctx context.Context, clientId ClientID, reqEditors ...RequestEditorFn) (*GetClientBrandingLogoResponse, error) {
+	rsp, err := c.GetClientBrandingLogo(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientBrandingLogoResponse(rsp)
+}
+
+// UploadClientBrandingLogoWithBodyWithResponse request with arbitrary body returning *UploadClientBrandingLogoResponse
+func (c *ClientWithResponses) UploadClientBrandingLogoWithBodyWithResponse(ctx context.Context, clientId ClientID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadClientBrandingLogoResponse, error) {
+	rsp, err := c.UploadClientBrandingLogoWithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadClientBrandingLogoResponse(rsp)
+}
+
+// DeleteClientWithResponse request returning *DeleteClientResponse
+func (c *ClientWithResponses) DeleteClientWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*DeleteClientResponse, error) {
+	rsp, err := c.DeleteClient(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientResponse(rsp)
+}
+
+// GetClientWithResponse request returning *GetClientResponse
+func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetClientResponse, error) {
+	rsp, err := c.GetClient(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientResponse(rsp)
+}
+
+// UpdateClientWithBodyWithResponse request with arbitrary body returning *UpdateClientResponse
+func (c *ClientWithResponses) UpdateClientWithBodyWithResponse(ctx context.Context, id ID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientResponse, error) {
+	rsp, err := c.UpdateClientWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClientWithResponse(ctx context.Context, id ID, body UpdateClientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientResponse, error) {
+	rsp, err := c.UpdateClient(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientResponse(rsp)
+}
+
+// ListCurrenciesWithResponse request returning *ListCurrenciesResponse
+func (c *ClientWithResponses) ListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCurrenciesResponse, error) {
+	rsp, err := c.ListCurrencies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCurrenciesResponse(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResponse
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, params *CreateProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, params *CreateProjectParams, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, id ID, params *DeleteProjectParams, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// PatchProjectWithBodyWithResponse request with arbitrary body returning *PatchProjectResponse
+func (c *ClientWithResponses) PatchProjectWithBodyWithResponse(ctx context.Context, id ID, params *PatchProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
+	rsp, err := c.PatchProjectWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchProjectWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id ID, params *PatchProjectParams, body PatchProjectApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectResponse, error) {
+	rsp, err := c.PatchProjectWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectResponse(rsp)
+}
+
+// ReplaceProjectWithBodyWithResponse request with arbitrary body returning *ReplaceProjectResponse
+func (c *ClientWithResponses) ReplaceProjectWithBodyWithResponse(ctx context.Context, id ID, params *ReplaceProjectParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceProjectResponse, error) {
+	rsp, err := c.ReplaceProjectWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceProjectWithResponse(ctx context.Context, id ID, params *ReplaceProjectParams, body ReplaceProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceProjectResponse, error) {
+	rsp, err := c.ReplaceProject(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceProjectResponse(rsp)
+}
+
+// ListProjectMembersWithResponse request returning *ListProjectMembersResponse
+func (c *ClientWithResponses) ListProjectMembersWithResponse(ctx context.Context, projectId ProjectID, reqEditors ...RequestEditorFn) (*ListProjectMembersResponse, error) {
+	rsp, err := c.ListProjectMembers(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectMembersResponse(rsp)
+}
+
+// AddProjectMemberWithBodyWithResponse request with arbitrary body returning *AddProjectMemberResponse
+func (c *ClientWithResponses) AddProjectMemberWithBodyWithResponse(ctx context.Context, projectId ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	rsp, err := c.AddProjectMemberWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddProjectMemberWithResponse(ctx context.Context, projectId ProjectID, body AddProjectMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectMemberResponse, error) {
+	rsp, err := c.AddProjectMember(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectMemberResponse(rsp)
+}
+
+// RemoveProjectMemberWithResponse request returning *RemoveProjectMemberResponse
+func (c *ClientWithResponses) RemoveProjectMemberWithResponse(ctx context.Context, projectId ProjectID, userId MemberUserID, reqEditors ...RequestEditorFn) (*RemoveProjectMemberResponse, error) {
+	rsp, err := c.RemoveProjectMember(ctx, projectId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveProjectMemberResponse(rsp)
+}
+
+// ListProjectTimeEntriesWithResponse request returning *ListProjectTimeEntriesResponse
+func (c *ClientWithResponses) ListProjectTimeEntriesWithResponse(ctx context.Context, projectId ProjectID, params *ListProjectTimeEntriesParams, reqEditors ...RequestEditorFn) (*ListProjectTimeEntriesResponse, error) {
+	rsp, err := c.ListProjectTimeEntries(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectTimeEntriesResponse(rsp)
+}
+
+// ExportReportWithResponse request returning *ExportReportResponse
+func (c *ClientWithResponses) ExportReportWithResponse(ctx context.Context, params *ExportReportParams, reqEditors ...RequestEditorFn) (*ExportReportResponse, error) {
+	rsp, err := c.ExportReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportReportResponse(rsp)
+}
+
+// GetPDFReportWithResponse request returning *GetPDFReportResponse
+func (c *ClientWithResponses) GetPDFReportWithResponse(ctx context.Context, params *GetPDFReportParams, reqEditors ...RequestEditorFn) (*GetPDFReportResponse, error) {
+	rsp, err := c.GetPDFReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPDFReportResponse(rsp)
+}
+
+// GetReportSummaryWithResponse request returning *GetReportSummaryResponse
+func (c *ClientWithResponses) GetReportSummaryWithResponse(ctx context.Context, params *GetReportSummaryParams, reqEditors ...RequestEditorFn) (*GetReportSummaryResponse, error) {
+	rsp, err := c.GetReportSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportSummaryResponse(rsp)
+}
+
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, params *GetSettingsParams, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsResponse(rsp)
+}
+
+// PatchSettingsWithBodyWithResponse request with arbitrary body returning *PatchSettingsResponse
+func (c *ClientWithResponses) PatchSettingsWithBodyWithResponse(ctx context.Context, params *PatchSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSettingsResponse, error) {
+	rsp, err := c.PatchSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *PatchSettingsParams, body PatchSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSettingsResponse, error) {
+	rsp, err := c.PatchSettingsWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSettingsResponse(rsp)
+}
+
+// ReplaceSettingsWithBodyWithResponse request with arbitrary body returning *ReplaceSettingsResponse
+func (c *ClientWithResponses) ReplaceSettingsWithBodyWithResponse(ctx context.Context, params *ReplaceSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceSettingsResponse, error) {
+	rsp, err := c.ReplaceSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceSettingsWithResponse(ctx context.Context, params *ReplaceSettingsParams, body ReplaceSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceSettingsResponse, error) {
+	rsp, err := c.ReplaceSettings(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceSettingsResponse(rsp)
+}
+
+// GetUserSettingsWithResponse request returning *GetUserSettingsResponse
+func (c *ClientWithResponses) GetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error) {
+	rsp, err := c.GetUserSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSettingsResponse(rsp)
+}
+
+// PatchUserSettingsWithBodyWithResponse request with arbitrary body returning *PatchUserSettingsResponse
+func (c *ClientWithResponses) PatchUserSettingsWithBodyWithResponse(ctx context.Context, params *PatchUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserSettingsResponse, error) {
+	rsp, err := c.PatchUserSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUserSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, params *PatchUserSettingsParams, body PatchUserSettingsApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserSettingsResponse, error) {
+	rsp, err := c.PatchUserSettingsWithApplicationMergePatchPlusJSONBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUserSettingsResponse(rsp)
+}
+
+// ReplaceUserSettingsWithBodyWithResponse request with arbitrary body returning *ReplaceUserSettingsResponse
+func (c *ClientWithResponses) ReplaceUserSettingsWithBodyWithResponse(ctx context.Context, params *ReplaceUserSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceUserSettingsResponse, error) {
+	rsp, err := c.ReplaceUserSettingsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceUserSettingsWithResponse(ctx context.Context, params *ReplaceUserSettingsParams, body ReplaceUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceUserSettingsResponse, error) {
+	rsp, err := c.ReplaceUserSettings(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceUserSettingsResponse(rsp)
+}
+
+// ListTagsWithResponse request returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+// ListTimeEntriesWithResponse request returning *ListTimeEntriesResponse
+func (c *ClientWithResponses) ListTimeEntriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTimeEntriesResponse, error) {
+	rsp, err := c.ListTimeEntries(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTimeEntriesResponse(rsp)
+}
+
+// CreateTimeEntryWithBodyWithResponse request with arbitrary body returning *CreateTimeEntryResponse
+func (c *ClientWithResponses) CreateTimeEntryWithBodyWithResponse(ctx context.Context, params *CreateTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimeEntryResponse, error) {
+	rsp, err := c.CreateTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTimeEntryWithResponse(ctx context.Context, params *CreateTimeEntryParams, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimeEntryResponse, error) {
+	rsp, err := c.CreateTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTimeEntryResponse(rsp)
+}
+
+// BulkDeleteTimeEntriesWithBodyWithResponse request with arbitrary body returning *BulkDeleteTimeEntriesResponse
+func (c *ClientWithResponses) BulkDeleteTimeEntriesWithBodyWithResponse(ctx context.Context, params *BulkDeleteTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkDeleteTimeEntriesResponse, error) {
+	rsp, err := c.BulkDeleteTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkDeleteTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkDeleteTimeEntriesWithResponse(ctx context.Context, params *BulkDeleteTimeEntriesParams, body BulkDeleteTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkDeleteTimeEntriesResponse, error) {
+	rsp, err := c.BulkDeleteTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkDeleteTimeEntriesResponse(rsp)
+}
+
+// BulkUpdateTimeEntriesWithBodyWithResponse request with arbitrary body returning *BulkUpdateTimeEntriesResponse
+func (c *ClientWithResponses) BulkUpdateTimeEntriesWithBodyWithResponse(ctx context.Context, params *BulkUpdateTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateTimeEntriesResponse, error) {
+	rsp, err := c.BulkUpdateTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkUpdateTimeEntriesWithResponse(ctx context.Context, params *BulkUpdateTimeEntriesParams, body BulkUpdateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateTimeEntriesResponse, error) {
+	rsp, err := c.BulkUpdateTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateTimeEntriesResponse(rsp)
+}
+
+// ImportTimeEntriesWithBodyWithResponse request with arbitrary body returning *ImportTimeEntriesResponse
+func (c *ClientWithResponses) ImportTimeEntriesWithBodyWithResponse(ctx context.Context, params *ImportTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTimeEntriesResponse, error) {
+	rsp, err := c.ImportTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTimeEntriesResponse(rsp)
+}
+
+// MergeTimeEntriesWithBodyWithResponse request with arbitrary body returning *MergeTimeEntriesResponse
+func (c *ClientWithResponses) MergeTimeEntriesWithBodyWithResponse(ctx context.Context, params *MergeTimeEntriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeTimeEntriesResponse, error) {
+	rsp, err := c.MergeTimeEntriesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeTimeEntriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MergeTimeEntriesWithResponse(ctx context.Context, params *MergeTimeEntriesParams, body MergeTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeTimeEntriesResponse, error) {
+	rsp, err := c.MergeTimeEntries(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeTimeEntriesResponse(rsp)
+}
+
+// SplitTimeEntryWithBodyWithResponse request with arbitrary body returning *SplitTimeEntryResponse
+func (c *ClientWithResponses) SplitTimeEntryWithBodyWithResponse(ctx context.Context, params *SplitTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitTimeEntryResponse, error) {
+	rsp, err := c.SplitTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSplitTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) SplitTimeEntryWithResponse(ctx context.Context, params *SplitTimeEntryParams, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitTimeEntryResponse, error) {
+	rsp, err := c.SplitTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSplitTimeEntryResponse(rsp)
+}
+
+// DeleteTimeEntryWithResponse request returning *DeleteTimeEntryResponse
+func (c *ClientWithResponses) DeleteTimeEntryWithResponse(ctx context.Context, id ID, params *DeleteTimeEntryParams, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResponse, error) {
+	rsp, err := c.DeleteTimeEntry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTimeEntryResponse(rsp)
+}
+
+// GetTimeEntryWithResponse request returning *GetTimeEntryResponse
+func (c *ClientWithResponses) GetTimeEntryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTimeEntryResponse, error) {
+	rsp, err := c.GetTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeEntryResponse(rsp)
+}
+
+// PatchTimeEntryWithBodyWithResponse request with arbitrary body returning *PatchTimeEntryResponse
+func (c *ClientWithResponses) PatchTimeEntryWithBodyWithResponse(ctx context.Context, id ID, params *PatchTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTimeEntryResponse, error) {
+	rsp, err := c.PatchTimeEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchTimeEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id ID, params *PatchTimeEntryParams, body PatchTimeEntryApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTimeEntryResponse, error) {
+	rsp, err := c.PatchTimeEntryWithApplicationMergePatchPlusJSONBody(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTimeEntryResponse(rsp)
+}
+
+// ReplaceTimeEntryWithBodyWithResponse request with arbitrary body returning *ReplaceTimeEntryResponse
+func (c *ClientWithResponses) ReplaceTimeEntryWithBodyWithResponse(ctx context.Context, id ID, params *ReplaceTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceTimeEntryResponse, error) {
+	rsp, err := c.ReplaceTimeEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceTimeEntryWithResponse(ctx context.Context, id ID, params *ReplaceTimeEntryParams, body ReplaceTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceTimeEntryResponse, error) {
+	rsp, err := c.ReplaceTimeEntry(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceTimeEntryResponse(rsp)
+}
+
+// SetTimeEntryBillableWithBodyWithResponse request with arbitrary body returning *SetTimeEntryBillableResponse
+func (c *ClientWithResponses) SetTimeEntryBillableWithBodyWithResponse(ctx context.Context, id ID, params *SetTimeEntryBillableParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryBillableResponse, error) {
+	rsp, err := c.SetTimeEntryBillableWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryBillableResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTimeEntryBillableWithResponse(ctx context.Context, id ID, params *SetTimeEntryBillableParams, body SetTimeEntryBillableJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryBillableResponse, error) {
+	rsp, err := c.SetTimeEntryBillable(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryBillableResponse(rsp)
+}
+
+// GetTimeEntryHistoryWithResponse request returning *GetTimeEntryHistoryResponse
+func (c *ClientWithResponses) GetTimeEntryHistoryWithResponse(ctx context.Context, id ID, reqEditors ...RequestEditorFn) (*GetTimeEntryHistoryResponse, error) {
+	rsp, err := c.GetTimeEntryHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeEntryHistoryResponse(rsp)
+}
+
+// SplitTimeEntryAtMidnightWithResponse request returning *SplitTimeEntryAtMidnightResponse
+func (c *ClientWithResponses) SplitTimeEntryAtMidnightWithResponse(ctx context.Context, id ID, params *SplitTimeEntryAtMidnightParams, reqEditors ...RequestEditorFn) (*SplitTimeEntryAtMidnightResponse, error) {
+	rsp, err := c.SplitTimeEntryAtMidnight(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSplitTimeEntryAtMidnightResponse(rsp)
+}
+
+// SetTimeEntryTagsWithBodyWithResponse request with arbitrary body returning *SetTimeEntryTagsResponse
+func (c *ClientWithResponses) SetTimeEntryTagsWithBodyWithResponse(ctx context.Context, id ID, params *SetTimeEntryTagsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResponse, error) {
+	rsp, err := c.SetTimeEntryTagsWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTimeEntryTagsWithResponse(ctx context.Context, id ID, params *SetTimeEntryTagsParams, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResponse, error) {
+	rsp, err := c.SetTimeEntryTags(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryTagsResponse(rsp)
+}
+
+// ApproveTimesheetWithBodyWithResponse request with arbitrary body returning *ApproveTimesheetResponse
+func (c *ClientWithResponses) ApproveTimesheetWithBodyWithResponse(ctx context.Context, params *ApproveTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveTimesheetResponse, error) {
+	rsp, err := c.ApproveTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveTimesheetWithResponse(ctx context.Context, params *ApproveTimesheetParams, body ApproveTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveTimesheetResponse, error) {
+	rsp, err := c.ApproveTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveTimesheetResponse(rsp)
+}
+
+// GetTimesheetPeriodWithResponse request returning *GetTimesheetPeriodResponse
+func (c *ClientWithResponses) GetTimesheetPeriodWithResponse(ctx context.Context, params *GetTimesheetPeriodParams, reqEditors ...RequestEditorFn) (*GetTimesheetPeriodResponse, error) {
+	rsp, err := c.GetTimesheetPeriod(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimesheetPeriodResponse(rsp)
+}
+
+// ListTimesheetPeriodsWithResponse request returning *ListTimesheetPeriodsResponse
+func (c *ClientWithResponses) ListTimesheetPeriodsWithResponse(ctx context.Context, params *ListTimesheetPeriodsParams, reqEditors ...RequestEditorFn) (*ListTimesheetPeriodsResponse, error) {
+	rsp, err := c.ListTimesheetPeriods(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTimesheetPeriodsResponse(rsp)
+}
+
+// RejectTimesheetWithBodyWithResponse request with arbitrary body returning *RejectTimesheetResponse
+func (c *ClientWithResponses) RejectTimesheetWithBodyWithResponse(ctx context.Context, params *RejectTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RejectTimesheetResponse, error) {
+	rsp, err := c.RejectTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) RejectTimesheetWithResponse(ctx context.Context, params *RejectTimesheetParams, body RejectTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*RejectTimesheetResponse, error) {
+	rsp, err := c.RejectTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectTimesheetResponse(rsp)
+}
+
+// SubmitTimesheetWithBodyWithResponse request with arbitrary body returning *SubmitTimesheetResponse
+func (c *ClientWithResponses) SubmitTimesheetWithBodyWithResponse(ctx context.Context, params *SubmitTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitTimesheetResponse, error) {
+	rsp, err := c.SubmitTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitTimesheetWithResponse(ctx context.Context, params *SubmitTimesheetParams, body SubmitTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitTimesheetResponse, error) {
+	rsp, err := c.SubmitTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitTimesheetResponse(rsp)
+}
+
+// GetWeeklyTimesheetWithResponse request returning *GetWeeklyTimesheetResponse
+func (c *ClientWithResponses) GetWeeklyTimesheetWithResponse(ctx context.Context, params *GetWeeklyTimesheetParams, reqEditors ...RequestEditorFn) (*GetWeeklyTimesheetResponse, error) {
+	rsp, err := c.GetWeeklyTimesheet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWeeklyTimesheetResponse(rsp)
+}
+
+// UpdateWeeklyTimesheetWithBodyWithResponse request with arbitrary body returning *UpdateWeeklyTimesheetResponse
+func (c *ClientWithResponses) UpdateWeeklyTimesheetWithBodyWithResponse(ctx context.Context, params *UpdateWeeklyTimesheetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWeeklyTimesheetResponse, error) {
+	rsp, err := c.UpdateWeeklyTimesheetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWeeklyTimesheetResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWeeklyTimesheetWithResponse(ctx context.Context, params *UpdateWeeklyTimesheetParams, body UpdateWeeklyTimesheetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWeeklyTimesheetResponse, error) {
+	rsp, err := c.UpdateWeeklyTimesheet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWeeklyTimesheetResponse(rsp)
+}
+
+// ListUsersWithResponse request returning *ListUsersResponse
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
+}
+
+// ListWorkspacesWithResponse request returning *ListWorkspacesResponse
+func (c *ClientWithResponses) ListWorkspacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error) {
+	rsp, err := c.ListWorkspaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkspacesResponse(rsp)
+}
+
+// CreateWorkspaceWithBodyWithResponse request with arbitrary body returning *CreateWorkspaceResponse
+func (c *ClientWithResponses) CreateWorkspaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkspaceResponse, error) {
+	rsp, err := c.CreateWorkspaceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWorkspaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWorkspaceWithResponse(ctx context.Context, body CreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkspaceResponse, error) {
+	rsp, err := c.CreateWorkspace(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWorkspaceResponse(rsp)
+}
+
+// ListWorkspaceMembersWithResponse request returning *ListWorkspaceMembersResponse
+func (c *ClientWithResponses) ListWorkspaceMembersWithResponse(ctx context.Context, workspaceId WorkspaceID, reqEditors ...RequestEditorFn) (*ListWorkspaceMembersResponse, error) {
+	rsp, err := c.ListWorkspaceMembers(ctx, workspaceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkspaceMembersResponse(rsp)
+}
+
+// AddWorkspaceMemberWithBodyWithResponse request with arbitrary body returning *AddWorkspaceMemberResponse
+func (c *ClientWithResponses) AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, workspaceId WorkspaceID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
+	rsp, err := c.AddWorkspaceMemberWithBody(ctx, workspaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddWorkspaceMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddWorkspaceMemberWithResponse(ctx context.Context, workspaceId WorkspaceID, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
+	rsp, err := c.AddWorkspaceMember(ctx, workspaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddWorkspaceMemberResponse(rsp)
+}
+
+// RemoveWorkspaceMemberWithResponse request returning *RemoveWorkspaceMemberResponse
+func (c *ClientWithResponses) RemoveWorkspaceMemberWithResponse(ctx context.Context, workspaceId WorkspaceID, userId MemberUserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error) {
+	rsp, err := c.RemoveWorkspaceMember(ctx, workspaceId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveWorkspaceMemberResponse(rsp)
+}
+
+// LegacyListWorkspacesWithResponse request returning *LegacyListWorkspacesResponse
+func (c *ClientWithResponses) LegacyListWorkspacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LegacyListWorkspacesResponse, error) {
+	rsp, err := c.LegacyListWorkspaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListWorkspacesResponse(rsp)
+}
+
+// LegacyCreateWorkspaceWithBodyWithResponse request with arbitrary body returning *LegacyCreateWorkspaceResponse
+func (c *ClientWithResponses) LegacyCreateWorkspaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyCreateWorkspaceResponse, error) {
+	rsp, err := c.LegacyCreateWorkspaceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateWorkspaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyCreateWorkspaceWithResponse(ctx context.Context, body LegacyCreateWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyCreateWorkspaceResponse, error) {
+	rsp, err := c.LegacyCreateWorkspace(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyCreateWorkspaceResponse(rsp)
+}
+
+// LegacyRemoveWorkspaceMemberWithResponse request returning *LegacyRemoveWorkspaceMemberResponse
+func (c *ClientWithResponses) LegacyRemoveWorkspaceMemberWithResponse(ctx context.Context, params *LegacyRemoveWorkspaceMemberParams, reqEditors ...RequestEditorFn) (*LegacyRemoveWorkspaceMemberResponse, error) {
+	rsp, err := c.LegacyRemoveWorkspaceMember(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyRemoveWorkspaceMemberResponse(rsp)
+}
+
+// LegacyListWorkspaceMembersWithResponse request returning *LegacyListWorkspaceMembersResponse
+func (c *ClientWithResponses) LegacyListWorkspaceMembersWithResponse(ctx context.Context, params *LegacyListWorkspaceMembersParams, reqEditors ...RequestEditorFn) (*LegacyListWorkspaceMembersResponse, error) {
+	rsp, err := c.LegacyListWorkspaceMembers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyListWorkspaceMembersResponse(rsp)
+}
+
+// LegacyAddWorkspaceMemberWithBodyWithResponse request with arbitrary body returning *LegacyAddWorkspaceMemberResponse
+func (c *ClientWithResponses) LegacyAddWorkspaceMemberWithBodyWithResponse(ctx context.Context, params *LegacyAddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LegacyAddWorkspaceMemberResponse, error) {
+	rsp, err := c.LegacyAddWorkspaceMemberWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyAddWorkspaceMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) LegacyAddWorkspaceMemberWithResponse(ctx context.Context, params *LegacyAddWorkspaceMemberParams, body LegacyAddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*LegacyAddWorkspaceMemberResponse, error) {
+	rsp, err := c.LegacyAddWorkspaceMember(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLegacyAddWorkspaceMemberResponse(rsp)
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// ParseLegacyGetBrandingResponse parses an HTTP response from a LegacyGetBrandingWithResponse call
+func ParseLegacyGetBrandingResponse(rsp *http.Response) (*LegacyGetBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyUpdateBrandingResponse parses an HTTP response from a LegacyUpdateBrandingWithResponse call
+func ParseLegacyUpdateBrandingResponse(rsp *http.Response) (*LegacyUpdateBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyUpdateBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyDeleteBrandingLogoResponse parses an HTTP response from a LegacyDeleteBrandingLogoWithResponse call
+func ParseLegacyDeleteBrandingLogoResponse(rsp *http.Response) (*LegacyDeleteBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyDeleteBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetBrandingLogoResponse parses an HTTP response from a LegacyGetBrandingLogoWithResponse call
+func ParseLegacyGetBrandingLogoResponse(rsp *http.Response) (*LegacyGetBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLegacyUploadBrandingLogoResponse parses an HTTP response from a LegacyUploadBrandingLogoWithResponse call
+func ParseLegacyUploadBrandingLogoResponse(rsp *http.Response) (*LegacyUploadBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyUploadBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogoUploadResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListClientsResponse parses an HTTP response from a LegacyListClientsWithResponse call
+func ParseLegacyListClientsResponse(rsp *http.Response) (*LegacyListClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyCreateClientResponse parses an HTTP response from a LegacyCreateClientWithResponse call
+func ParseLegacyCreateClientResponse(rsp *http.Response) (*LegacyCreateClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyCreateClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyDeleteClientResponse parses an HTTP response from a LegacyDeleteClientWithResponse call
+func ParseLegacyDeleteClientResponse(rsp *http.Response) (*LegacyDeleteClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyDeleteClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetClientResponse parses an HTTP response from a LegacyGetClientWithResponse call
+func ParseLegacyGetClientResponse(rsp *http.Response) (*LegacyGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyUpdateClientResponse parses an HTTP response from a LegacyUpdateClientWithResponse call
+func ParseLegacyUpdateClientResponse(rsp *http.Response) (*LegacyUpdateClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyUpdateClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListCurrenciesResponse parses an HTTP response from a LegacyListCurrenciesWithResponse call
+func ParseLegacyListCurrenciesResponse(rsp *http.Response) (*LegacyListCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Currency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResponse parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResponse(rsp *http.Response) (*GetOpenAPIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListProjectsResponse parses an HTTP response from a LegacyListProjectsWithResponse call
+func ParseLegacyListProjectsResponse(rsp *http.Response) (*LegacyListProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyCreateProjectResponse parses an HTTP response from a LegacyCreateProjectWithResponse call
+func ParseLegacyCreateProjectResponse(rsp *http.Response) (*LegacyCreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyCreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyRemoveProjectMemberResponse parses an HTTP response from a LegacyRemoveProjectMemberWithResponse call
+func ParseLegacyRemoveProjectMemberResponse(rsp *http.Response) (*LegacyRemoveProjectMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyRemoveProjectMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListProjectMembersResponse parses an HTTP response from a LegacyListProjectMembersWithResponse call
+func ParseLegacyListProjectMembersResponse(rsp *http.Response) (*LegacyListProjectMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListProjectMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyAddProjectMemberResponse parses an HTTP response from a LegacyAddProjectMemberWithResponse call
+func ParseLegacyAddProjectMemberResponse(rsp *http.Response) (*LegacyAddProjectMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyAddProjectMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyDeleteProjectResponse parses an HTTP response from a LegacyDeleteProjectWithResponse call
+func ParseLegacyDeleteProjectResponse(rsp *http.Response) (*LegacyDeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyDeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetProjectResponse parses an HTTP response from a LegacyGetProjectWithResponse call
+func ParseLegacyGetProjectResponse(rsp *http.Response) (*LegacyGetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyPatchProjectResponse parses an HTTP response from a LegacyPatchProjectWithResponse call
+func ParseLegacyPatchProjectResponse(rsp *http.Response) (*LegacyPatchProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyPatchProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyReplaceProjectResponse parses an HTTP response from a LegacyReplaceProjectWithResponse call
+func ParseLegacyReplaceProjectResponse(rsp *http.Response) (*LegacyReplaceProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyReplaceProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyExportReportResponse parses an HTTP response from a LegacyExportReportWithResponse call
+func ParseLegacyExportReportResponse(rsp *http.Response) (*LegacyExportReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyExportReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetPDFReportResponse parses an HTTP response from a LegacyGetPDFReportWithResponse call
+func ParseLegacyGetPDFReportResponse(rsp *http.Response) (*LegacyGetPDFReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetPDFReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetReportSummaryResponse parses an HTTP response from a LegacyGetReportSummaryWithResponse call
+func ParseLegacyGetReportSummaryResponse(rsp *http.Response) (*LegacyGetReportSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetReportSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Summary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetSettingsResponse parses an HTTP response from a LegacyGetSettingsWithResponse call
+func ParseLegacyGetSettingsResponse(rsp *http.Response) (*LegacyGetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyPatchSettingsResponse parses an HTTP response from a LegacyPatchSettingsWithResponse call
+func ParseLegacyPatchSettingsResponse(rsp *http.Response) (*LegacyPatchSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyPatchSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyReplaceSettingsResponse parses an HTTP response from a LegacyReplaceSettingsWithResponse call
+func ParseLegacyReplaceSettingsResponse(rsp *http.Response) (*LegacyReplaceSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyReplaceSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetUserSettingsResponse parses an HTTP response from a LegacyGetUserSettingsWithResponse call
+func ParseLegacyGetUserSettingsResponse(rsp *http.Response) (*LegacyGetUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyPatchUserSettingsResponse parses an HTTP response from a LegacyPatchUserSettingsWithResponse call
+func ParseLegacyPatchUserSettingsResponse(rsp *http.Response) (*LegacyPatchUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyPatchUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyReplaceUserSettingsResponse parses an HTTP response from a LegacyReplaceUserSettingsWithResponse call
+func ParseLegacyReplaceUserSettingsResponse(rsp *http.Response) (*LegacyReplaceUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyReplaceUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListTagsResponse parses an HTTP response from a LegacyListTagsWithResponse call
+func ParseLegacyListTagsResponse(rsp *http.Response) (*LegacyListTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyCreateTagResponse parses an HTTP response from a LegacyCreateTagWithResponse call
+func ParseLegacyCreateTagResponse(rsp *http.Response) (*LegacyCreateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyCreateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListTimeEntriesResponse parses an HTTP response from a LegacyListTimeEntriesWithResponse call
+func ParseLegacyListTimeEntriesResponse(rsp *http.Response) (*LegacyListTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyCreateTimeEntryResponse parses an HTTP response from a LegacyCreateTimeEntryWithResponse call
+func ParseLegacyCreateTimeEntryResponse(rsp *http.Response) (*LegacyCreateTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyCreateTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacySetTimeEntryBillableResponse parses an HTTP response from a LegacySetTimeEntryBillableWithResponse call
+func ParseLegacySetTimeEntryBillableResponse(rsp *http.Response) (*LegacySetTimeEntryBillableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacySetTimeEntryBillableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BillableResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyBulkDeleteTimeEntriesResponse parses an HTTP response from a LegacyBulkDeleteTimeEntriesWithResponse call
+func ParseLegacyBulkDeleteTimeEntriesResponse(rsp *http.Response) (*LegacyBulkDeleteTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyBulkDeleteTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyBulkUpdateTimeEntriesResponse parses an HTTP response from a LegacyBulkUpdateTimeEntriesWithResponse call
+func ParseLegacyBulkUpdateTimeEntriesResponse(rsp *http.Response) (*LegacyBulkUpdateTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyBulkUpdateTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetTimeEntryHistoryResponse parses an HTTP response from a LegacyGetTimeEntryHistoryWithResponse call
+func ParseLegacyGetTimeEntryHistoryResponse(rsp *http.Response) (*LegacyGetTimeEntryHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetTimeEntryHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntryHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyImportTimeEntriesResponse parses an HTTP response from a LegacyImportTimeEntriesWithResponse call
+func ParseLegacyImportTimeEntriesResponse(rsp *http.Response) (*LegacyImportTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyImportTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyMergeTimeEntriesResponse parses an HTTP response from a LegacyMergeTimeEntriesWithResponse call
+func ParseLegacyMergeTimeEntriesResponse(rsp *http.Response) (*LegacyMergeTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyMergeTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListProjectTimeEntriesResponse parses an HTTP response from a LegacyListProjectTimeEntriesWithResponse call
+func ParseLegacyListProjectTimeEntriesResponse(rsp *http.Response) (*LegacyListProjectTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListProjectTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyDeleteTimeEntryResponse parses an HTTP response from a LegacyDeleteTimeEntryWithResponse call
+func ParseLegacyDeleteTimeEntryResponse(rsp *http.Response) (*LegacyDeleteTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyDeleteTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetTimeEntryResponse parses an HTTP response from a LegacyGetTimeEntryWithResponse call
+func ParseLegacyGetTimeEntryResponse(rsp *http.Response) (*LegacyGetTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyPatchTimeEntryResponse parses an HTTP response from a LegacyPatchTimeEntryWithResponse call
+func ParseLegacyPatchTimeEntryResponse(rsp *http.Response) (*LegacyPatchTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyPatchTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyReplaceTimeEntryResponse parses an HTTP response from a LegacyReplaceTimeEntryWithResponse call
+func ParseLegacyReplaceTimeEntryResponse(rsp *http.Response) (*LegacyReplaceTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyReplaceTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacySplitTimeEntryResponse parses an HTTP response from a LegacySplitTimeEntryWithResponse call
+func ParseLegacySplitTimeEntryResponse(rsp *http.Response) (*LegacySplitTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacySplitTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacySplitTimeEntryAtMidnightResponse parses an HTTP response from a LegacySplitTimeEntryAtMidnightWithResponse call
+func ParseLegacySplitTimeEntryAtMidnightResponse(rsp *http.Response) (*LegacySplitTimeEntryAtMidnightResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacySplitTimeEntryAtMidnightResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacySetTimeEntryTagsResponse parses an HTTP response from a LegacySetTimeEntryTagsWithResponse call
+func ParseLegacySetTimeEntryTagsResponse(rsp *http.Response) (*LegacySetTimeEntryTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacySetTimeEntryTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyApproveTimesheetResponse parses an HTTP response from a LegacyApproveTimesheetWithResponse call
+func ParseLegacyApproveTimesheetResponse(rsp *http.Response) (*LegacyApproveTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyApproveTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetTimesheetPeriodResponse parses an HTTP response from a LegacyGetTimesheetPeriodWithResponse call
+func ParseLegacyGetTimesheetPeriodResponse(rsp *http.Response) (*LegacyGetTimesheetPeriodResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetTimesheetPeriodResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListTimesheetPeriodsResponse parses an HTTP response from a LegacyListTimesheetPeriodsWithResponse call
+func ParseLegacyListTimesheetPeriodsResponse(rsp *http.Response) (*LegacyListTimesheetPeriodsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListTimesheetPeriodsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyRejectTimesheetResponse parses an HTTP response from a LegacyRejectTimesheetWithResponse call
+func ParseLegacyRejectTimesheetResponse(rsp *http.Response) (*LegacyRejectTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyRejectTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacySubmitTimesheetResponse parses an HTTP response from a LegacySubmitTimesheetWithResponse call
+func ParseLegacySubmitTimesheetResponse(rsp *http.Response) (*LegacySubmitTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacySubmitTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyGetWeeklyTimesheetResponse parses an HTTP response from a LegacyGetWeeklyTimesheetWithResponse call
+func ParseLegacyGetWeeklyTimesheetResponse(rsp *http.Response) (*LegacyGetWeeklyTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyGetWeeklyTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Timesheet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyUpdateWeeklyTimesheetResponse parses an HTTP response from a LegacyUpdateWeeklyTimesheetWithResponse call
+func ParseLegacyUpdateWeeklyTimesheetResponse(rsp *http.Response) (*LegacyUpdateWeeklyTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyUpdateWeeklyTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Timesheet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListUsersResponse parses an HTTP response from a LegacyListUsersWithResponse call
+func ParseLegacyListUsersResponse(rsp *http.Response) (*LegacyListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBrandingResponse parses an HTTP response from a GetBrandingWithResponse call
+func ParseGetBrandingResponse(rsp *http.Response) (*GetBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBrandingResponse parses an HTTP response from a UpdateBrandingWithResponse call
+func ParseUpdateBrandingResponse(rsp *http.Response) (*UpdateBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBrandingLogoResponse parses an HTTP response from a DeleteBrandingLogoWithResponse call
+func ParseDeleteBrandingLogoResponse(rsp *http.Response) (*DeleteBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBrandingLogoResponse parses an HTTP response from a GetBrandingLogoWithResponse call
+func ParseGetBrandingLogoResponse(rsp *http.Response) (*GetBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadBrandingLogoResponse parses an HTTP response from a UploadBrandingLogoWithResponse call
+func ParseUploadBrandingLogoResponse(rsp *http.Response) (*UploadBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogoUploadResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClientsResponse parses an HTTP response from a ListClientsWithResponse call
+func ParseListClientsResponse(rsp *http.Response) (*ListClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClientResponse parses an HTTP response from a CreateClientWithResponse call
+func ParseCreateClientResponse(rsp *http.Response) (*CreateClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientBrandingResponse parses an HTTP response from a GetClientBrandingWithResponse call
+func ParseGetClientBrandingResponse(rsp *http.Response) (*GetClientBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClientBrandingResponse parses an HTTP response from a UpdateClientBrandingWithResponse call
+func ParseUpdateClientBrandingResponse(rsp *http.Response) (*UpdateClientBrandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClientBrandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Branding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClientBrandingLogoResponse parses an HTTP response from a DeleteClientBrandingLogoWithResponse call
+func ParseDeleteClientBrandingLogoResponse(rsp *http.Response) (*DeleteClientBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClientBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientBrandingLogoResponse parses an HTTP response from a GetClientBrandingLogoWithResponse call
+func ParseGetClientBrandingLogoResponse(rsp *http.Response) (*GetClientBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadClientBrandingLogoResponse parses an HTTP response from a UploadClientBrandingLogoWithResponse call
+func ParseUploadClientBrandingLogoResponse(rsp *http.Response) (*UploadClientBrandingLogoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadClientBrandingLogoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogoUploadResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClientResponse parses an HTTP response from a DeleteClientWithResponse call
+func ParseDeleteClientResponse(rsp *http.Response) (*DeleteClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientResponse parses an HTTP response from a GetClientWithResponse call
+func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClientResponse parses an HTTP response from a UpdateClientWithResponse call
+func ParseUpdateClientResponse(rsp *http.Response) (*UpdateClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Client
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCurrenciesResponse parses an HTTP response from a ListCurrenciesWithResponse call
+func ParseListCurrenciesResponse(rsp *http.Response) (*ListCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Currency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchProjectResponse parses an HTTP response from a PatchProjectWithResponse call
+func ParsePatchProjectResponse(rsp *http.Response) (*PatchProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceProjectResponse parses an HTTP response from a ReplaceProjectWithResponse call
+func ParseReplaceProjectResponse(rsp *http.Response) (*ReplaceProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectMembersResponse parses an HTTP response from a ListProjectMembersWithResponse call
+func ParseListProjectMembersResponse(rsp *http.Response) (*ListProjectMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddProjectMemberResponse parses an HTTP response from a AddProjectMemberWithResponse call
+func ParseAddProjectMemberResponse(rsp *http.Response) (*AddProjectMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddProjectMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveProjectMemberResponse parses an HTTP response from a RemoveProjectMemberWithResponse call
+func ParseRemoveProjectMemberResponse(rsp *http.Response) (*RemoveProjectMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveProjectMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectTimeEntriesResponse parses an HTTP response from a ListProjectTimeEntriesWithResponse call
+func ParseListProjectTimeEntriesResponse(rsp *http.Response) (*ListProjectTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportReportResponse parses an HTTP response from a ExportReportWithResponse call
+func ParseExportReportResponse(rsp *http.Response) (*ExportReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPDFReportResponse parses an HTTP response from a GetPDFReportWithResponse call
+func ParseGetPDFReportResponse(rsp *http.Response) (*GetPDFReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPDFReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetReportSummaryResponse parses an HTTP response from a GetReportSummaryWithResponse call
+func ParseGetReportSummaryResponse(rsp *http.Response) (*GetReportSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReportSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Summary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSettingsResponse parses an HTTP response from a GetSettingsWithResponse call
+func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchSettingsResponse parses an HTTP response from a PatchSettingsWithResponse call
+func ParsePatchSettingsResponse(rsp *http.Response) (*PatchSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceSettingsResponse parses an HTTP response from a ReplaceSettingsWithResponse call
+func ParseReplaceSettingsResponse(rsp *http.Response) (*ReplaceSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Settings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserSettingsResponse parses an HTTP response from a GetUserSettingsWithResponse call
+func ParseGetUserSettingsResponse(rsp *http.Response) (*GetUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchUserSettingsResponse parses an HTTP response from a PatchUserSettingsWithResponse call
+func ParsePatchUserSettingsResponse(rsp *http.Response) (*PatchUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceUserSettingsResponse parses an HTTP response from a ReplaceUserSettingsWithResponse call
+func ParseReplaceUserSettingsResponse(rsp *http.Response) (*ReplaceUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTimeEntriesResponse parses an HTTP response from a ListTimeEntriesWithResponse call
+func ParseListTimeEntriesResponse(rsp *http.Response) (*ListTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTimeEntryResponse parses an HTTP response from a CreateTimeEntryWithResponse call
+func ParseCreateTimeEntryResponse(rsp *http.Response) (*CreateTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkDeleteTimeEntriesResponse parses an HTTP response from a BulkDeleteTimeEntriesWithResponse call
+func ParseBulkDeleteTimeEntriesResponse(rsp *http.Response) (*BulkDeleteTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkDeleteTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkUpdateTimeEntriesResponse parses an HTTP response from a BulkUpdateTimeEntriesWithResponse call
+func ParseBulkUpdateTimeEntriesResponse(rsp *http.Response) (*BulkUpdateTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkUpdateTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTimeEntriesResponse parses an HTTP response from a ImportTimeEntriesWithResponse call
+func ParseImportTimeEntriesResponse(rsp *http.Response) (*ImportTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMergeTimeEntriesResponse parses an HTTP response from a MergeTimeEntriesWithResponse call
+func ParseMergeTimeEntriesResponse(rsp *http.Response) (*MergeTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergeTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSplitTimeEntryResponse parses an HTTP response from a SplitTimeEntryWithResponse call
+func ParseSplitTimeEntryResponse(rsp *http.Response) (*SplitTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SplitTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTimeEntryResponse parses an HTTP response from a DeleteTimeEntryWithResponse call
+func ParseDeleteTimeEntryResponse(rsp *http.Response) (*DeleteTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimeEntryResponse parses an HTTP response from a GetTimeEntryWithResponse call
+func ParseGetTimeEntryResponse(rsp *http.Response) (*GetTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchTimeEntryResponse parses an HTTP response from a PatchTimeEntryWithResponse call
+func ParsePatchTimeEntryResponse(rsp *http.Response) (*PatchTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplaceTimeEntryResponse parses an HTTP response from a ReplaceTimeEntryWithResponse call
+func ParseReplaceTimeEntryResponse(rsp *http.Response) (*ReplaceTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplaceTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTimeEntryBillableResponse parses an HTTP response from a SetTimeEntryBillableWithResponse call
+func ParseSetTimeEntryBillableResponse(rsp *http.Response) (*SetTimeEntryBillableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTimeEntryBillableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BillableResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimeEntryHistoryResponse parses an HTTP response from a GetTimeEntryHistoryWithResponse call
+func ParseGetTimeEntryHistoryResponse(rsp *http.Response) (*GetTimeEntryHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimeEntryHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntryHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSplitTimeEntryAtMidnightResponse parses an HTTP response from a SplitTimeEntryAtMidnightWithResponse call
+func ParseSplitTimeEntryAtMidnightResponse(rsp *http.Response) (*SplitTimeEntryAtMidnightResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SplitTimeEntryAtMidnightResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTimeEntryTagsResponse parses an HTTP response from a SetTimeEntryTagsWithResponse call
+func ParseSetTimeEntryTagsResponse(rsp *http.Response) (*SetTimeEntryTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTimeEntryTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveTimesheetResponse parses an HTTP response from a ApproveTimesheetWithResponse call
+func ParseApproveTimesheetResponse(rsp *http.Response) (*ApproveTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimesheetPeriodResponse parses an HTTP response from a GetTimesheetPeriodWithResponse call
+func ParseGetTimesheetPeriodResponse(rsp *http.Response) (*GetTimesheetPeriodResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimesheetPeriodResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTimesheetPeriodsResponse parses an HTTP response from a ListTimesheetPeriodsWithResponse call
+func ParseListTimesheetPeriodsResponse(rsp *http.Response) (*ListTimesheetPeriodsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTimesheetPeriodsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRejectTimesheetResponse parses an HTTP response from a RejectTimesheetWithResponse call
+func ParseRejectTimesheetResponse(rsp *http.Response) (*RejectTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RejectTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitTimesheetResponse parses an HTTP response from a SubmitTimesheetWithResponse call
+func ParseSubmitTimesheetResponse(rsp *http.Response) (*SubmitTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimesheetPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWeeklyTimesheetResponse parses an HTTP response from a GetWeeklyTimesheetWithResponse call
+func ParseGetWeeklyTimesheetResponse(rsp *http.Response) (*GetWeeklyTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWeeklyTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Timesheet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWeeklyTimesheetResponse parses an HTTP response from a UpdateWeeklyTimesheetWithResponse call
+func ParseUpdateWeeklyTimesheetResponse(rsp *http.Response) (*UpdateWeeklyTimesheetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWeeklyTimesheetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Timesheet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUsersResponse parses an HTTP response from a ListUsersWithResponse call
+func ParseListUsersResponse(rsp *http.Response) (*ListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWorkspacesResponse parses an HTTP response from a ListWorkspacesWithResponse call
+func ParseListWorkspacesResponse(rsp *http.Response) (*ListWorkspacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkspacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Workspace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWorkspaceResponse parses an HTTP response from a CreateWorkspaceWithResponse call
+func ParseCreateWorkspaceResponse(rsp *http.Response) (*CreateWorkspaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWorkspaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Workspace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWorkspaceMembersResponse parses an HTTP response from a ListWorkspaceMembersWithResponse call
+func ParseListWorkspaceMembersResponse(rsp *http.Response) (*ListWorkspaceMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkspaceMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WorkspaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddWorkspaceMemberResponse parses an HTTP response from a AddWorkspaceMemberWithResponse call
+func ParseAddWorkspaceMemberResponse(rsp *http.Response) (*AddWorkspaceMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddWorkspaceMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkspaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveWorkspaceMemberResponse parses an HTTP response from a RemoveWorkspaceMemberWithResponse call
+func ParseRemoveWorkspaceMemberResponse(rsp *http.Response) (*RemoveWorkspaceMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveWorkspaceMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListWorkspacesResponse parses an HTTP response from a LegacyListWorkspacesWithResponse call
+func ParseLegacyListWorkspacesResponse(rsp *http.Response) (*LegacyListWorkspacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListWorkspacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Workspace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyCreateWorkspaceResponse parses an HTTP response from a LegacyCreateWorkspaceWithResponse call
+func ParseLegacyCreateWorkspaceResponse(rsp *http.Response) (*LegacyCreateWorkspaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyCreateWorkspaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Workspace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyRemoveWorkspaceMemberResponse parses an HTTP response from a LegacyRemoveWorkspaceMemberWithResponse call
+func ParseLegacyRemoveWorkspaceMemberResponse(rsp *http.Response) (*LegacyRemoveWorkspaceMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyRemoveWorkspaceMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyListWorkspaceMembersResponse parses an HTTP response from a LegacyListWorkspaceMembersWithResponse call
+func ParseLegacyListWorkspaceMembersResponse(rsp *http.Response) (*LegacyListWorkspaceMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyListWorkspaceMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WorkspaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLegacyAddWorkspaceMemberResponse parses an HTTP response from a LegacyAddWorkspaceMemberWithResponse call
+func ParseLegacyAddWorkspaceMemberResponse(rsp *http.Response) (*LegacyAddWorkspaceMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LegacyAddWorkspaceMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkspaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,5 @@
+// Package apiclient is a typed client for the API, generated from the OpenAPI
+// document in pkg/api. Run go generate after changing the document.
+package apiclient
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml ../api/openapi.json
@@ -0,0 +1,130 @@
+// Package client is a Go SDK for the API. It wraps the generated
+// pkg/apiclient with authentication, retries and typed errors.
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"side-sync/pkg/apiclient"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	defaultRetries = 3
+	defaultBackoff = 250 * time.Millisecond
+)
+
+// Client calls the /api/v1 routes of a server.
+type Client struct {
+	api *apiclient.ClientWithResponses
+}
+
+type config struct {
+	httpClient  *http.Client
+	token       string
+	userID      int
+	workspaceID int
+	retries     int
+	backoff     time.Duration
+}
+
+// Option configures a Client.
+type Option func(*config)
+
+// WithHTTPClient sends requests with httpClient instead of a client with a
+// 30 second timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken sends token as a bearer token. The API does not read it; it is
+// for the authenticating proxy in front of the server, which checks it and
+// sets X-User-ID for the API.
+func WithToken(token string) Option {
+	return func(c *config) {
+		c.token = token
+	}
+}
+
+// WithUserID makes requests act as the user, through the X-User-ID header.
+// The server only accepts the header when it trusts the proxy setting it.
+func WithUserID(userID int) Option {
+	return func(c *config) {
+		c.userID = userID
+	}
+}
+
+// WithWorkspaceID makes requests use the workspace, through the
+// X-Workspace-ID header, instead of the user's oldest workspace.
+func WithWorkspaceID(workspaceID int) Option {
+	return func(c *config) {
+		c.workspaceID = workspaceID
+	}
+}
+
+// WithRetries sets how often a failed request is retried and the delay before
+// the first retry, which doubles with every further retry. Zero retries turns
+// retrying off.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(c *config) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// New returns a client for the server at baseURL, such as
+// http://localhost:8080.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	cfg := config{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retries:    defaultRetries,
+		backoff:    defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	api, err := apiclient.NewClientWithResponses(baseURL,
+		apiclient.WithHTTPClient(&retryingDoer{client: cfg.httpClient, retries: cfg.retries, backoff: cfg.backoff}),
+		apiclient.WithRequestEditorFn(cfg.authorize))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: api}, nil
+}
+
+// API returns the generated client the SDK is built on, for calls the SDK
+// does not wrap. Requests made through it are authenticated and retried too.
+func (c *Client) API() *apiclient.ClientWithResponses {
+	return c.api
+}
+
+func (cfg config) authorize(ctx context.Context, req *http.Request) error {
+	if cfg.token != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.token)
+	}
+	if cfg.userID != 0 {
+		req.Header.Set("X-User-ID", strconv.Itoa(cfg.userID))
+	}
+	if cfg.workspaceID != 0 {
+		req.Header.Set("X-Workspace-ID", strconv.Itoa(cfg.workspaceID))
+	}
+	return nil
+}
+
+// newIdempotencyKey returns a random Idempotency-Key, so that retries of a
+// create request do not create duplicates.
+func newIdempotencyKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
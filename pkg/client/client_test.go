@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"side-sync/pkg/models"
+)
+
+func TestAuthorizeSetsHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, WithToken("secret"), WithUserID(2), WithWorkspaceID(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListProjects(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"Authorization": "Bearer secret", "X-User-ID": "2", "X-Workspace-ID": "3"}
+	for name, value := range want {
+		if got := header.Get(name); got != value {
+			t.Errorf("%s: got %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestAuthorizeWithoutOptions(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListProjects(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"Authorization", "X-User-ID", "X-Workspace-ID"} {
+		if got := header.Get(name); got != "" {
+			t.Errorf("%s: got %q, want no header", name, got)
+		}
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Project not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetProject(context.Background(), 7)
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("got %v, want it to match ErrNotFound", err)
+	}
+	if errors.Is(err, ErrForbidden) {
+		t.Errorf("got %v, want it not to match ErrForbidden", err)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got %T, want *Error", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Message != "Project not found" {
+		t.Errorf("got status %d and message %q, want 404 and the server's message", apiErr.StatusCode, apiErr.Message)
+	}
+}
+
+func TestCreateRetriesWithSameKey(t *testing.T) {
+	var mu sync.Mutex
+	var keys, bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		bodies = append(bodies, string(body))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var project models.Project
+		json.Unmarshal(body, &project)
+		project.ID = 5
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(project)
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, WithRetries(3, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	project, err := c.CreateProject(context.Background(), models.Project{Name: "Website"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if project.ID != 5 || project.Name != "Website" {
+		t.Errorf("got project %d %q, want 5 \"Website\"", project.ID, project.Name)
+	}
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("got keys %q, want the same key on both requests", keys)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("got bodies %q, want the same body on both requests", bodies)
+	}
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is returned when the API answers with an error status. Compare it
+// with the sentinel errors below using errors.Is, or read the status code
+// with errors.As.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, message)
+}
+
+// Is reports whether target is the sentinel error for e's status code.
+func (e *Error) Is(target error) bool {
+	sentinel, ok := target.(*Error)
+	return ok && sentinel.Message == "" && sentinel.StatusCode == e.StatusCode
+}
+
+var (
+	ErrBadRequest           = &Error{StatusCode: http.StatusBadRequest}
+	ErrUnauthorized         = &Error{StatusCode: http.StatusUnauthorized}
+	ErrForbidden            = &Error{StatusCode: http.StatusForbidden}
+	ErrNotFound             = &Error{StatusCode: http.StatusNotFound}
+	ErrConflict             = &Error{StatusCode: http.StatusConflict}
+	ErrPreconditionFailed   = &Error{StatusCode: http.StatusPreconditionFailed}
+	ErrUnprocessableEntity  = &Error{StatusCode: http.StatusUnprocessableEntity}
+	ErrPreconditionRequired = &Error{StatusCode: http.StatusPreconditionRequired}
+)
+
+// responseError turns a response with an unexpected status into an *Error.
+// The API answers errors with a plain text message.
+func responseError(resp *http.Response, body []byte) error {
+	return &Error{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+}
+
+// decoded returns the decoded body of a response, or the error the API
+// answered with when the response did not have the expected status.
+func decoded[T any](value *T, resp *http.Response, body []byte) (*T, error) {
+	if value == nil {
+		return nil, responseError(resp, body)
+	}
+	return value, nil
+}
+
+// decodedList is decoded for responses holding a list.
+func decodedList[T any](value *[]T, resp *http.Response, body []byte) ([]T, error) {
+	if value == nil {
+		return nil, responseError(resp, body)
+	}
+	return *value, nil
+}
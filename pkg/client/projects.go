@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+
+	"side-sync/pkg/apiclient"
+	"side-sync/pkg/models"
+)
+
+// ListProjects returns the projects of the user's workspaces.
+func (c *Client) ListProjects(ctx context.Context) ([]models.Project, error) {
+	resp, err := c.api.ListProjectsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) GetProject(ctx context.Context, id int) (*models.Project, error) {
+	resp, err := c.api.GetProjectWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// CreateProject creates a project. The request carries an Idempotency-Key,
+// so it is retried without creating the project twice.
+func (c *Client) CreateProject(ctx context.Context, project models.Project) (*models.Project, error) {
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.api.CreateProjectWithResponse(ctx, &apiclient.CreateProjectParams{IdempotencyKey: &key}, project)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// UpdateProject replaces the project with the ID of project.
+func (c *Client) UpdateProject(ctx context.Context, project models.Project) (*models.Project, error) {
+	resp, err := c.api.ReplaceProjectWithResponse(ctx, project.ID, nil, project)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// PatchProject changes only the fields in patch, a JSON merge patch in which
+// nil clears a field.
+func (c *Client) PatchProject(ctx context.Context, id int, patch map[string]interface{}) (*models.Project, error) {
+	resp, err := c.api.PatchProjectWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, id, nil, patch)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id int) error {
+	resp, err := c.api.DeleteProjectWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	_, err = decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+	return err
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"side-sync/pkg/apiclient"
+	"side-sync/pkg/models"
+)
+
+type (
+	// SummaryParams selects the grouping and filters of a summary.
+	SummaryParams = apiclient.GetReportSummaryParams
+	// PDFReportParams selects the projects, filters and layout of a PDF
+	// report. One of ProjectId, ProjectIds and ClientId is required.
+	PDFReportParams = apiclient.GetPDFReportParams
+	// ExportParams selects the format and filters of an export.
+	ExportParams = apiclient.ExportReportParams
+)
+
+// ReportSummary returns hours, billable hours and amount totals.
+func (c *Client) ReportSummary(ctx context.Context, params *SummaryParams) (*models.Summary, error) {
+	resp, err := c.api.GetReportSummaryWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// PDFReport returns the PDF document of a time report.
+func (c *Client) PDFReport(ctx context.Context, params *PDFReportParams) ([]byte, error) {
+	resp, err := c.api.GetPDFReportWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.Body, nil
+}
+
+// ExportReport returns time entries as a CSV or XLSX file.
+func (c *Client) ExportReport(ctx context.Context, params *ExportParams) ([]byte, error) {
+	resp, err := c.api.ExportReportWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryAfter caps how long a Retry-After header can make a retry wait.
+const maxRetryAfter = 30 * time.Second
+
+// retryingDoer sends requests and retries those that failed on the way or
+// got a temporary error status, waiting longer before every retry. Only
+// requests that are safe to repeat are retried: GET, HEAD, PUT and DELETE,
+// and POST when it carries an Idempotency-Key.
+type retryingDoer struct {
+	client  *http.Client
+	retries int
+	backoff time.Duration
+}
+
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := d.client.Do(attemptReq)
+		if attempt >= d.retries || !retryable(req, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := d.delay(attempt, resp)
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// delay returns how long to wait before retrying after the given attempt:
+// the backoff doubled for every earlier retry plus up to half of it again,
+// or what the server asked for in Retry-After, up to maxRetryAfter.
+func (d *retryingDoer) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if after, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && after >= 0 {
+			if after > int(maxRetryAfter/time.Second) {
+				return maxRetryAfter
+			}
+			return time.Duration(after) * time.Second
+		}
+	}
+	delay := d.backoff << attempt
+	return delay + rand.N(delay/2+1)
+}
+
+// retryable reports whether a request that got resp or err may be sent again.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	case http.MethodPost:
+		if req.Header.Get("Idempotency-Key") == "" {
+			return false
+		}
+	default:
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	// The API answers 409 while the first request with the same
+	// Idempotency-Key is still running.
+	return resp.StatusCode == http.StatusConflict && req.Header.Get("Idempotency-Key") != ""
+}
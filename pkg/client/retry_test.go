@@ -0,0 +1,254 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingServer answers the first failures requests with status and later
+// ones with 200 OK. It counts the requests it got.
+func failingServer(t *testing.T, failures int, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(calls.Add(1)) <= failures {
+			for name, values := range header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func testDoer(retries int, backoff time.Duration) *retryingDoer {
+	return &retryingDoer{client: &http.Client{Timeout: 5 * time.Second}, retries: retries, backoff: backoff}
+}
+
+func TestRetriesTemporaryStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		server, calls := failingServer(t, 2, status, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testDoer(3, time.Millisecond).Do(req)
+		if err != nil {
+			t.Fatalf("status %d: %v", status, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+			t.Errorf("status %d: got %d after %d requests, want 200 after 3", status, resp.StatusCode, calls.Load())
+		}
+	}
+}
+
+func TestDoesNotRetryOtherStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusNotFound, http.StatusConflict} {
+		server, calls := failingServer(t, 1, status, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testDoer(3, time.Millisecond).Do(req)
+		if err != nil {
+			t.Fatalf("status %d: %v", status, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status || calls.Load() != 1 {
+			t.Errorf("status %d: got %d after %d requests, want it after 1", status, resp.StatusCode, calls.Load())
+		}
+	}
+}
+
+func TestGivesUpAfterRetries(t *testing.T) {
+	server, calls := failingServer(t, 10, http.StatusServiceUnavailable, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testDoer(2, time.Millisecond).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 3 {
+		t.Errorf("got %d after %d requests, want 503 after 3", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetriesTransportErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testDoer(3, time.Millisecond).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() < 2 {
+		t.Errorf("got %d after %d requests, want 200 after a retry", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestBackoffDoubles(t *testing.T) {
+	d := testDoer(5, 100*time.Millisecond)
+	for attempt := 0; attempt < 5; attempt++ {
+		base := 100 * time.Millisecond << attempt
+		for i := 0; i < 20; i++ {
+			if delay := d.delay(attempt, nil); delay < base || delay > base+base/2 {
+				t.Fatalf("attempt %d: delay %v, want between %v and %v", attempt, delay, base, base+base/2)
+			}
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	d := testDoer(3, 100*time.Millisecond)
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"0", 0},
+		{"2", 2 * time.Second},
+		{"3600", maxRetryAfter},
+		{"99999999999999999", maxRetryAfter},
+	}
+	for _, test := range tests {
+		resp := &http.Response{Header: http.Header{"Retry-After": {test.header}}}
+		if delay := d.delay(0, resp); delay != test.want {
+			t.Errorf("Retry-After %s: delay %v, want %v", test.header, delay, test.want)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"soon"}}}
+	if delay := d.delay(0, resp); delay < 100*time.Millisecond || delay > 150*time.Millisecond {
+		t.Errorf("invalid Retry-After: delay %v, want the backoff", delay)
+	}
+}
+
+func TestRetryAfterOverridesBackoff(t *testing.T) {
+	server, calls := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := testDoer(3, time.Hour).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Errorf("got %d after %d requests, want 200 after 2", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestPostWithoutIdempotencyKeyIsNotRetried(t *testing.T) {
+	server, calls := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte(`{}`)))
+	resp, err := testDoer(3, time.Millisecond).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Errorf("got %d after %d requests, want 503 after 1", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		bodies = append(bodies, string(body))
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte(`{"name":"Website"}`)))
+	req.Header.Set("Idempotency-Key", "abc")
+	resp, err := testDoer(3, time.Millisecond).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated || len(bodies) != 2 {
+		t.Fatalf("got %d after %d requests, want 201 after 2", resp.StatusCode, len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"Website"}` || keys[i] != "abc" {
+			t.Errorf("request %d: body %q with key %q, want the original body and key", i+1, body, keys[i])
+		}
+	}
+}
+
+func TestBodyWithoutGetBodyIsNotRetried(t *testing.T) {
+	server, calls := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, io.NopCloser(bytes.NewReader([]byte(`{}`))))
+	resp, err := testDoer(3, time.Millisecond).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 1 {
+		t.Errorf("got %d requests, want 1", calls.Load())
+	}
+}
+
+func TestContextCanceledWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	done := make(chan error, 1)
+	go func() {
+		_, err := testDoer(3, time.Hour).Do(req)
+		done <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Do kept waiting after the context was canceled")
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	"side-sync/pkg/apiclient"
+	"side-sync/pkg/models"
+)
+
+// Currency is a currency the settings can use.
+type Currency = apiclient.Currency
+
+// GetSettings returns the settings in effect for the user: the workspace
+// settings with the user's overrides applied.
+func (c *Client) GetSettings(ctx context.Context) (*models.Settings, error) {
+	resp, err := c.api.GetSettingsWithResponse(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// GetWorkspaceSettings returns the workspace's own settings, without the
+// user's overrides.
+func (c *Client) GetWorkspaceSettings(ctx context.Context) (*models.Settings, error) {
+	scope := apiclient.GetSettingsParamsScopeWorkspace
+	resp, err := c.api.GetSettingsWithResponse(ctx, &apiclient.GetSettingsParams{Scope: &scope})
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// UpdateSettings replaces the settings of the workspace. Only admins may
+// change them.
+func (c *Client) UpdateSettings(ctx context.Context, settings models.Settings) (*models.Settings, error) {
+	resp, err := c.api.ReplaceSettingsWithResponse(ctx, nil, settings)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// PatchSettings changes only the workspace settings in patch, a JSON merge
+// patch.
+func (c *Client) PatchSettings(ctx context.Context, patch map[string]interface{}) (*models.Settings, error) {
+	resp, err := c.api.PatchSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, nil, patch)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// GetUserSettings returns the user's own overrides of the workspace settings.
+func (c *Client) GetUserSettings(ctx context.Context) (*models.UserSettings, error) {
+	resp, err := c.api.GetUserSettingsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) UpdateUserSettings(ctx context.Context, settings models.UserSettings) (*models.UserSettings, error) {
+	resp, err := c.api.ReplaceUserSettingsWithResponse(ctx, nil, settings)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// PatchUserSettings changes only the overrides in patch, a JSON merge patch
+// in which nil makes the workspace value apply again.
+func (c *Client) PatchUserSettings(ctx context.Context, patch map[string]interface{}) (*models.UserSettings, error) {
+	resp, err := c.api.PatchUserSettingsWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, nil, patch)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// ListCurrencies returns the currencies the settings can use.
+func (c *Client) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	resp, err := c.api.ListCurrenciesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
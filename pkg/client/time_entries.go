@@ -0,0 +1,207 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"side-sync/pkg/apiclient"
+	"side-sync/pkg/models"
+)
+
+type (
+	// EntryFilter narrows the time entries of a project.
+	EntryFilter = apiclient.ListProjectTimeEntriesParams
+	// SplitRequest splits an entry at a point in time or into equal parts.
+	SplitRequest = apiclient.SplitRequest
+	// MergeRequest merges adjacent entries into the earliest one.
+	MergeRequest = apiclient.MergeRequest
+	// BulkSelection names entries by ID or by a filter.
+	BulkSelection     = apiclient.BulkSelection
+	BulkUpdateRequest = apiclient.BulkUpdateRequest
+	// BulkResponse reports the outcome for every selected entry.
+	BulkResponse = apiclient.BulkResponse
+	ImportResult = apiclient.ImportResult
+)
+
+// ListTimeEntries returns the time entries of the user's workspaces.
+func (c *Client) ListTimeEntries(ctx context.Context) ([]models.TimeEntry, error) {
+	resp, err := c.api.ListTimeEntriesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// ListProjectTimeEntries returns the time entries of a project; filter may
+// be nil.
+func (c *Client) ListProjectTimeEntries(ctx context.Context, projectID int, filter *EntryFilter) ([]models.TimeEntry, error) {
+	resp, err := c.api.ListProjectTimeEntriesWithResponse(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) GetTimeEntry(ctx context.Context, id int) (*models.TimeEntry, error) {
+	resp, err := c.api.GetTimeEntryWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// CreateTimeEntry creates a time entry. The request carries an
+// Idempotency-Key, so it is retried without creating the entry twice.
+func (c *Client) CreateTimeEntry(ctx context.Context, entry models.TimeEntry) (*models.TimeEntry, error) {
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.api.CreateTimeEntryWithResponse(ctx, &apiclient.CreateTimeEntryParams{IdempotencyKey: &key}, entry)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// UpdateTimeEntry replaces the time entry with the ID of entry.
+func (c *Client) UpdateTimeEntry(ctx context.Context, entry models.TimeEntry) (*models.TimeEntry, error) {
+	resp, err := c.api.ReplaceTimeEntryWithResponse(ctx, entry.ID, nil, entry)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// PatchTimeEntry changes only the fields in patch, a JSON merge patch in
+// which nil clears a field.
+func (c *Client) PatchTimeEntry(ctx context.Context, id int, patch map[string]interface{}) (*models.TimeEntry, error) {
+	resp, err := c.api.PatchTimeEntryWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, id, nil, patch)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) DeleteTimeEntry(ctx context.Context, id int) error {
+	resp, err := c.api.DeleteTimeEntryWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	_, err = decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+	return err
+}
+
+func (c *Client) SetTimeEntryBillable(ctx context.Context, id int, billable bool) error {
+	resp, err := c.api.SetTimeEntryBillableWithResponse(ctx, id, nil, apiclient.BillableUpdate{Billable: billable})
+	if err != nil {
+		return err
+	}
+	_, err = decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+	return err
+}
+
+// SetTimeEntryTags replaces the tags of a time entry and returns them.
+func (c *Client) SetTimeEntryTags(ctx context.Context, id int, tags []string) ([]string, error) {
+	resp, err := c.api.SetTimeEntryTagsWithResponse(ctx, id, nil, apiclient.TagsUpdate{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+	result, err := decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+	if err != nil || result.Tags == nil {
+		return nil, err
+	}
+	return *result.Tags, nil
+}
+
+// SplitTimeEntry splits a finished entry and returns the parts in order.
+func (c *Client) SplitTimeEntry(ctx context.Context, request SplitRequest) ([]models.TimeEntry, error) {
+	resp, err := c.api.SplitTimeEntryWithResponse(ctx, nil, request)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// SplitTimeEntryAtMidnight stores an entry as one entry per day of its user's
+// timezone and returns the parts in order.
+func (c *Client) SplitTimeEntryAtMidnight(ctx context.Context, id int) ([]models.TimeEntry, error) {
+	resp, err := c.api.SplitTimeEntryAtMidnightWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (c *Client) MergeTimeEntries(ctx context.Context, request MergeRequest) (*models.TimeEntry, error) {
+	resp, err := c.api.MergeTimeEntriesWithResponse(ctx, nil, request)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// TimeEntryHistory returns the splits and merges an entry took part in.
+func (c *Client) TimeEntryHistory(ctx context.Context, id int) ([]models.TimeEntryHistory, error) {
+	resp, err := c.api.GetTimeEntryHistoryWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodedList(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// BulkUpdateTimeEntries applies one update to many entries. Entries that
+// cannot be changed are reported in the response, not as an error.
+func (c *Client) BulkUpdateTimeEntries(ctx context.Context, request BulkUpdateRequest) (*BulkResponse, error) {
+	resp, err := c.api.BulkUpdateTimeEntriesWithResponse(ctx, nil, request)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// BulkDeleteTimeEntries deletes many entries. Entries that cannot be deleted
+// are reported in the response, not as an error.
+func (c *Client) BulkDeleteTimeEntries(ctx context.Context, selection BulkSelection) (*BulkResponse, error) {
+	resp, err := c.api.BulkDeleteTimeEntriesWithResponse(ctx, nil, selection)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// ImportTimeEntries imports the time entries in a CSV file into a project.
+// The file is read into memory so the request can be retried; it carries an
+// Idempotency-Key, so retries do not import the entries twice.
+func (c *Client) ImportTimeEntries(ctx context.Context, projectID int, filename string, csv io.Reader) (*ImportResult, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField("project_id", strconv.Itoa(projectID)); err != nil {
+		return nil, err
+	}
+	file, err := form.CreateFormFile("csv_file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(file, csv); err != nil {
+		return nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.api.ImportTimeEntriesWithBodyWithResponse(ctx, &apiclient.ImportTimeEntriesParams{IdempotencyKey: &key}, form.FormDataContentType(), &body)
+	if err != nil {
+		return nil, err
+	}
+	return decoded(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
@@ -0,0 +1,401 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+type Generator struct{}
+
+type ReportConfig struct {
+	Projects       []models.Project
+	TimeEntries    []models.TimeEntry
+	Settings       models.Settings
+	IncludePricing bool
+	DateFrom       string
+	DateTo         string
+	BillableFilter string
+}
+
+type row struct {
+	project     string
+	date        string
+	start       string
+	end         string
+	duration    string
+	hours       float64
+	description string
+	billable    bool
+	rate        float64
+	cost        float64
+}
+
+type projectTotals struct {
+	project       models.Project
+	rate          float64
+	totalHours    float64
+	billableHours float64
+	billableCost  float64
+	rows          []row
+}
+
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+func IsSupportedFormat(format string) bool {
+	return format == FormatCSV || format == FormatXLSX
+}
+
+// Generate renders the export with dates and times in the timezone of
+// config.Settings.
+func (g *Generator) Generate(format string, config ReportConfig) (*bytes.Buffer, error) {
+	config.TimeEntries = models.LocalizeEntries(config.TimeEntries, config.Settings.Location())
+
+	switch format {
+	case FormatCSV:
+		return g.GenerateCSV(config)
+	case FormatXLSX:
+		return g.GenerateXLSX(config)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func (g *Generator) GenerateCSV(config ReportConfig) (*bytes.Buffer, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(g.header(config, true)); err != nil {
+		return nil, fmt.Errorf("failed to write CSV header: %v", err)
+	}
+
+	for _, totals := range g.calculateTotals(config) {
+		for _, r := range totals.rows {
+			record := []string{
+				csvText(r.project),
+				r.date,
+				r.start,
+				r.end,
+				r.duration,
+				fmt.Sprintf("%.2f", r.hours),
+				csvText(r.description),
+				formatBillable(r.billable),
+			}
+			if config.IncludePricing {
+				record = append(record, formatPriced(r.billable, r.rate), formatPriced(r.billable, r.cost))
+			}
+
+			if err := writer.Write(record); err != nil {
+				return nil, fmt.Errorf("failed to write CSV row: %v", err)
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to generate CSV: %v", err)
+	}
+
+	return &buf, nil
+}
+
+func (g *Generator) GenerateXLSX(config ReportConfig) (*bytes.Buffer, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	summarySheet := "Summary"
+	if err := f.SetSheetName("Sheet1", summarySheet); err != nil {
+		return nil, fmt.Errorf("failed to create summary sheet: %v", err)
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true, Color: "FFFFFF"},
+		Fill: excelize.Fill{Type: "pattern", Color: []string{"3498DB"}, Pattern: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create header style: %v", err)
+	}
+
+	boldStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bold style: %v", err)
+	}
+
+	allTotals := g.calculateTotals(config)
+	currency := currencyCode(config.Settings)
+
+	f.SetCellValue(summarySheet, "A1", "TIME TRACKING REPORT")
+	f.SetCellStyle(summarySheet, "A1", "A1", boldStyle)
+	f.SetCellValue(summarySheet, "A2", "Period")
+	f.SetCellValue(summarySheet, "B2", formatPeriod(config.DateFrom, config.DateTo))
+	f.SetCellValue(summarySheet, "A3", "Currency")
+	f.SetCellValue(summarySheet, "B3", currency)
+	f.SetCellValue(summarySheet, "A4", "Generated on")
+	f.SetCellValue(summarySheet, "B4", time.Now().In(config.Settings.Location()).Format("2006-01-02 15:04"))
+
+	summaryHeader := []interface{}{"Project", "Total Hours", "Billable Hours", "Non-Billable Hours"}
+	if config.IncludePricing {
+		summaryHeader = append(summaryHeader, "Rate", "Billable Amount")
+	}
+	f.SetSheetRow(summarySheet, "A6", &summaryHeader)
+	lastCol, _ := excelize.ColumnNumberToName(len(summaryHeader))
+	f.SetCellStyle(summarySheet, "A6", lastCol+"6", headerStyle)
+
+	var grandTotal, grandBillable, grandCost float64
+	rowIndex := 7
+	for _, totals := range allTotals {
+		values := []interface{}{
+			totals.project.Name,
+			round2(totals.totalHours),
+			round2(totals.billableHours),
+			round2(totals.totalHours - totals.billableHours),
+		}
+		if config.IncludePricing {
+			values = append(values, totals.rate, round2(totals.billableCost))
+		}
+		f.SetSheetRow(summarySheet, fmt.Sprintf("A%d", rowIndex), &values)
+
+		grandTotal += totals.totalHours
+		grandBillable += totals.billableHours
+		grandCost += totals.billableCost
+		rowIndex++
+	}
+
+	totalValues := []interface{}{"Total", round2(grandTotal), round2(grandBillable), round2(grandTotal - grandBillable)}
+	if config.IncludePricing {
+		totalValues = append(totalValues, "", round2(grandCost))
+	}
+	f.SetSheetRow(summarySheet, fmt.Sprintf("A%d", rowIndex), &totalValues)
+	f.SetCellStyle(summarySheet, fmt.Sprintf("A%d", rowIndex), fmt.Sprintf("%s%d", lastCol, rowIndex), boldStyle)
+	f.SetColWidth(summarySheet, "A", "A", 30)
+	f.SetColWidth(summarySheet, "B", lastCol, 18)
+
+	usedNames := map[string]bool{strings.ToLower(summarySheet): true}
+	for _, totals := range allTotals {
+		sheet := uniqueSheetName(totals.project.Name, usedNames)
+		if _, err := f.NewSheet(sheet); err != nil {
+			return nil, fmt.Errorf("failed to create sheet for project %s: %v", totals.project.Name, err)
+		}
+
+		header := g.header(config, false)
+		headerValues := make([]interface{}, len(header))
+		for i, h := range header {
+			headerValues[i] = h
+		}
+		f.SetSheetRow(sheet, "A1", &headerValues)
+		lastCol, _ := excelize.ColumnNumberToName(len(header))
+		f.SetCellStyle(sheet, "A1", lastCol+"1", headerStyle)
+
+		for i, r := range totals.rows {
+			values := []interface{}{r.date, r.start, r.end, r.duration, round2(r.hours), r.description, formatBillable(r.billable)}
+			if config.IncludePricing {
+				if r.billable {
+					values = append(values, r.rate, round2(r.cost))
+				} else {
+					values = append(values, "-", "-")
+				}
+			}
+			f.SetSheetRow(sheet, fmt.Sprintf("A%d", i+2), &values)
+		}
+
+		totalRow := len(totals.rows) + 2
+		totalValues := []interface{}{"Total", "", "", "", round2(totals.totalHours), "", ""}
+		if config.IncludePricing {
+			totalValues = append(totalValues, "", round2(totals.billableCost))
+		}
+		f.SetSheetRow(sheet, fmt.Sprintf("A%d", totalRow), &totalValues)
+		f.SetCellStyle(sheet, fmt.Sprintf("A%d", totalRow), fmt.Sprintf("%s%d", lastCol, totalRow), boldStyle)
+		f.SetColWidth(sheet, "A", "E", 12)
+		f.SetColWidth(sheet, "F", "F", 50)
+	}
+
+	f.SetActiveSheet(0)
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate XLSX: %v", err)
+	}
+
+	return buf, nil
+}
+
+func (g *Generator) header(config ReportConfig, includeProject bool) []string {
+	var header []string
+	if includeProject {
+		header = append(header, "Project")
+	}
+	header = append(header, "Date", "Start", "End", "Duration", "Hours", "Description", "Billable")
+	if config.IncludePricing {
+		header = append(header, "Rate", "Cost")
+	}
+	return header
+}
+
+func (g *Generator) calculateTotals(config ReportConfig) []*projectTotals {
+	byProject := make(map[int]*projectTotals)
+	var ordered []*projectTotals
+
+	for _, project := range config.Projects {
+		totals := &projectTotals{project: project, rate: effectiveRate(project, config.Settings)}
+		byProject[project.ID] = totals
+		ordered = append(ordered, totals)
+	}
+
+	for _, entry := range config.TimeEntries {
+		totals, ok := byProject[entry.ProjectID]
+		if !ok {
+			continue
+		}
+
+		var hours float64
+		if entry.Duration != nil {
+			hours = float64(*entry.Duration) / 3600
+		}
+
+		r := row{
+			project:     totals.project.Name,
+			date:        entry.StartTime.Format("2006-01-02"),
+			start:       entry.StartTime.Format("15:04"),
+			duration:    formatDuration(entry.Duration),
+			hours:       hours,
+			description: entry.Description,
+			billable:    entry.Billable,
+			rate:        totals.rate,
+		}
+		if entry.EndTime != nil {
+			r.end = entry.EndTime.Format("15:04")
+		}
+
+		totals.totalHours += hours
+		if entry.Billable {
+			r.cost = hours * totals.rate
+			totals.billableHours += hours
+			totals.billableCost += r.cost
+		}
+
+		totals.rows = append(totals.rows, r)
+	}
+
+	return ordered
+}
+
+func (g *Generator) GetFilename(name, format string) string {
+	return fmt.Sprintf("%s-time-report-%s.%s",
+		strings.ReplaceAll(strings.ToLower(name), " ", "-"),
+		time.Now().Format("2006-01-02"),
+		format)
+}
+
+func (g *Generator) ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// csvText escapes user entered text for CSV. Spreadsheets run cells that
+// start with =, +, - or @ as formulas, so those get a leading apostrophe.
+func csvText(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
+
+func effectiveRate(project models.Project, settings models.Settings) float64 {
+	if project.HourlyRate != nil {
+		return *project.HourlyRate
+	}
+	if settings.DefaultHourlyRate != nil {
+		return *settings.DefaultHourlyRate
+	}
+	return 0
+}
+
+func currencyCode(settings models.Settings) string {
+	if settings.Currency != "" {
+		return settings.Currency
+	}
+	return "EUR"
+}
+
+func formatDuration(duration *int) string {
+	if duration == nil {
+		return "00:00"
+	}
+	return fmt.Sprintf("%02d:%02d", *duration/3600, (*duration%3600)/60)
+}
+
+func formatBillable(billable bool) string {
+	if billable {
+		return "Yes"
+	}
+	return "No"
+}
+
+func formatPriced(billable bool, amount float64) string {
+	if !billable {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", amount)
+}
+
+func formatPeriod(dateFrom, dateTo string) string {
+	switch {
+	case dateFrom != "" && dateTo != "":
+		return fmt.Sprintf("%s - %s", dateFrom, dateTo)
+	case dateFrom != "":
+		return fmt.Sprintf("From %s", dateFrom)
+	case dateTo != "":
+		return fmt.Sprintf("Until %s", dateTo)
+	default:
+		return "All time"
+	}
+}
+
+func round2(value float64) float64 {
+	return float64(int64(value*100+0.5)) / 100
+}
+
+func uniqueSheetName(name string, used map[string]bool) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if cleaned == "" {
+		cleaned = "Project"
+	}
+
+	base := truncateRunes(cleaned, 31)
+	candidate := base
+	for i := 2; used[strings.ToLower(candidate)]; i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		candidate = truncateRunes(cleaned, 31-len(suffix)) + suffix
+	}
+
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
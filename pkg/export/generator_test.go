@@ -0,0 +1,45 @@
+package export
+
+import (
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"side-sync/pkg/models"
+)
+
+func TestGenerateCSVEscapesFormulas(t *testing.T) {
+	duration := 3600
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	descriptions := []string{"=HYPERLINK(\"http://example.com\")", "+1", "-1", "@SUM(A1)", "Design review"}
+
+	config := ReportConfig{
+		Projects: []models.Project{{ID: 1, Name: "=Website"}},
+		Settings: models.Settings{Timezone: "UTC"},
+	}
+	for _, description := range descriptions {
+		config.TimeEntries = append(config.TimeEntries, models.TimeEntry{
+			ProjectID: 1, Description: description, StartTime: start, EndTime: &end, Duration: &duration,
+		})
+	}
+
+	buf, err := NewGenerator().GenerateCSV(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"'=HYPERLINK(\"http://example.com\")", "'+1", "'-1", "'@SUM(A1)", "Design review"}
+	for i, record := range records[1:] {
+		if record[0] != "'=Website" {
+			t.Errorf("row %d: project %q, want it escaped", i+1, record[0])
+		}
+		if record[6] != want[i] {
+			t.Errorf("row %d: description %q, want %q", i+1, record[6], want[i])
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+type Branding struct {
+	ID              int       `json:"id" db:"id"`
+	WorkspaceID     int       `json:"workspace_id" db:"workspace_id"`
+	ClientID        *int      `json:"client_id" db:"client_id"`
+	CompanyName     string    `json:"company_name" db:"company_name"`
+	CompanyAddress  string    `json:"company_address" db:"company_address"`
+	PrimaryColor    string    `json:"primary_color" db:"primary_color"`
+	FooterText      string    `json:"footer_text" db:"footer_text"`
+	Logo            []byte    `json:"-" db:"logo"`
+	LogoContentType string    `json:"logo_content_type" db:"logo_content_type"`
+	HasLogo         bool      `json:"has_logo" db:"-"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Merge returns the branding with empty fields filled in from fallback.
+func (b Branding) Merge(fallback Branding) Branding {
+	if b.CompanyName == "" {
+		b.CompanyName = fallback.CompanyName
+	}
+	if b.CompanyAddress == "" {
+		b.CompanyAddress = fallback.CompanyAddress
+	}
+	if b.PrimaryColor == "" {
+		b.PrimaryColor = fallback.PrimaryColor
+	}
+	if b.FooterText == "" {
+		b.FooterText = fallback.FooterText
+	}
+	if len(b.Logo) == 0 {
+		b.Logo = fallback.Logo
+		b.LogoContentType = fallback.LogoContentType
+	}
+	b.HasLogo = len(b.Logo) > 0
+	return b
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type Client struct {
+	ID              int       `json:"id" db:"id"`
+	Name            string    `json:"name" db:"name"`
+	UserID          int       `json:"user_id" db:"user_id"`
+	WorkspaceID     int       `json:"workspace_id" db:"workspace_id"`
+	Locale          string    `json:"locale" db:"locale"`
+	RoundingMode    *string   `json:"rounding_mode" db:"rounding_mode"`
+	RoundingMinutes int       `json:"rounding_minutes" db:"rounding_minutes"`
+	RoundingScope   string    `json:"rounding_scope" db:"rounding_scope"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
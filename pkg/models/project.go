@@ -3,11 +3,17 @@ package models
 import "time"
 
 type Project struct {
-	ID          int        `json:"id" db:"id"`
-	Name        string     `json:"name" db:"name"`
-	Description string     `json:"description" db:"description"`
-	UserID      int        `json:"user_id" db:"user_id"`
-	HourlyRate  *float64   `json:"hourly_rate" db:"hourly_rate"`
-	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+	ID              int       `json:"id" db:"id"`
+	Name            string    `json:"name" db:"name"`
+	Description     string    `json:"description" db:"description"`
+	UserID          int       `json:"user_id" db:"user_id"`
+	WorkspaceID     int       `json:"workspace_id" db:"workspace_id"`
+	ClientID        *int      `json:"client_id" db:"client_id"`
+	HourlyRate      *float64  `json:"hourly_rate" db:"hourly_rate"`
+	RoundingMode    *string   `json:"rounding_mode" db:"rounding_mode"`
+	RoundingMinutes int       `json:"rounding_minutes" db:"rounding_minutes"`
+	RoundingScope   string    `json:"rounding_scope" db:"rounding_scope"`
+	LockedUntil     *string   `json:"locked_until" db:"locked_until"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	RoundNone    = "none"
+	RoundUp      = "up"
+	RoundNearest = "nearest"
+	RoundDown    = "down"
+)
+
+const (
+	RoundPerEntry = "entry"
+	RoundPerDay   = "day"
+)
+
+// Rounding rounds durations to a multiple of Minutes, either entry by entry
+// or as daily totals. The zero value leaves durations untouched.
+type Rounding struct {
+	Mode    string `json:"mode"`
+	Minutes int    `json:"minutes"`
+	Scope   string `json:"scope"`
+}
+
+func IsSupportedRoundingMode(mode string) bool {
+	return mode == RoundNone || mode == RoundUp || mode == RoundNearest || mode == RoundDown
+}
+
+func IsSupportedRoundingScope(scope string) bool {
+	return scope == RoundPerEntry || scope == RoundPerDay
+}
+
+// ResolveRounding returns the rounding policy that applies to a project: its
+// own override, else its client's override, else the default from settings.
+func ResolveRounding(settings Settings, client *Client, project Project) Rounding {
+	if project.RoundingMode != nil {
+		return Rounding{Mode: *project.RoundingMode, Minutes: project.RoundingMinutes, Scope: project.RoundingScope}
+	}
+	if client != nil && client.RoundingMode != nil {
+		return Rounding{Mode: *client.RoundingMode, Minutes: client.RoundingMinutes, Scope: client.RoundingScope}
+	}
+	return Rounding{Mode: settings.RoundingMode, Minutes: settings.RoundingMinutes, Scope: settings.RoundingScope}
+}
+
+func (r Rounding) enabled() bool {
+	return r.Minutes > 0 && r.Mode != "" && r.Mode != RoundNone
+}
+
+// Apply returns seconds rounded to the configured increment.
+func (r Rounding) Apply(seconds int) int {
+	if !r.enabled() || seconds <= 0 {
+		return seconds
+	}
+
+	increment := r.Minutes * 60
+	remainder := seconds % increment
+	if remainder == 0 {
+		return seconds
+	}
+
+	switch r.Mode {
+	case RoundDown:
+		return seconds - remainder
+	case RoundNearest:
+		if remainder*2 < increment {
+			return seconds - remainder
+		}
+		return seconds - remainder + increment
+	default:
+		return seconds - remainder + increment
+	}
+}
+
+// RoundEntries returns copies of entries with rounded durations. With the day
+// scope, each user's billable and non-billable entries of a project are
+// rounded as daily totals, and each total is spread over the day's entries in proportion
+// to their durations so the entries still add up to it. Days are taken in loc.
+// The rounded_time_entries view applies the same rules in the database.
+func (r Rounding) RoundEntries(entries []TimeEntry, loc *time.Location) []TimeEntry {
+	rounded := make([]TimeEntry, len(entries))
+	copy(rounded, entries)
+	if !r.enabled() {
+		return rounded
+	}
+
+	if r.Scope != RoundPerDay {
+		for i := range rounded {
+			if rounded[i].Duration != nil {
+				duration := r.Apply(*rounded[i].Duration)
+				rounded[i].Duration = &duration
+			}
+		}
+		return rounded
+	}
+
+	type dayKey struct {
+		projectID int
+		userID    int
+		date      string
+		billable  bool
+	}
+	days := make(map[dayKey][]int)
+	for i, entry := range rounded {
+		if entry.Duration == nil {
+			continue
+		}
+		key := dayKey{entry.ProjectID, entry.UserID, entry.StartTime.In(loc).Format("2006-01-02"), entry.Billable}
+		days[key] = append(days[key], i)
+	}
+
+	for _, indexes := range days {
+		sort.SliceStable(indexes, func(a, b int) bool {
+			ea, eb := rounded[indexes[a]], rounded[indexes[b]]
+			if !ea.StartTime.Equal(eb.StartTime) {
+				return ea.StartTime.Before(eb.StartTime)
+			}
+			return ea.ID < eb.ID
+		})
+
+		total := 0
+		for _, i := range indexes {
+			total += *rounded[i].Duration
+		}
+		if total <= 0 {
+			continue
+		}
+
+		target := float64(r.Apply(total))
+		running, allocated := 0, 0
+		for _, i := range indexes {
+			running += *rounded[i].Duration
+			next := int(math.Round(float64(running) * target / float64(total)))
+			duration := next - allocated
+			allocated = next
+			rounded[i].Duration = &duration
+		}
+	}
+
+	return rounded
+}
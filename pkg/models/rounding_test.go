@@ -0,0 +1,47 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundEntriesPerDayKeepsUsersApart(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	entry := func(id, userID, seconds int) TimeEntry {
+		return TimeEntry{ID: id, ProjectID: 1, UserID: userID, StartTime: start.Add(time.Duration(id) * time.Hour), Duration: &seconds, Billable: true}
+	}
+	// Together the users logged 25 minutes, rounded to 30 and split 6 to 24;
+	// apart, each user's time is rounded up to the next quarter hour.
+	entries := []TimeEntry{entry(1, 1, 300), entry(2, 2, 1200)}
+	want := map[int]int{1: 900, 2: 1800}
+
+	rounding := Rounding{Mode: RoundUp, Minutes: 15, Scope: RoundPerDay}
+	for _, rounded := range rounding.RoundEntries(entries, time.UTC) {
+		if *rounded.Duration != want[rounded.ID] {
+			t.Errorf("entry %d of user %d: rounded to %d seconds, want %d", rounded.ID, rounded.UserID, *rounded.Duration, want[rounded.ID])
+		}
+	}
+}
+
+func TestClipSharesTheRoundedDuration(t *testing.T) {
+	// 23:50 to 00:10 is 20 minutes, rounded up to 30 before it is clipped.
+	start := time.Date(2026, 3, 2, 23, 50, 0, 0, time.UTC)
+	end := start.Add(20 * time.Minute)
+	duration := 1200
+	entries := []TimeEntry{{ID: 1, ProjectID: 1, StartTime: start, EndTime: &end, Duration: &duration}}
+
+	rounding := Rounding{Mode: RoundUp, Minutes: 15, Scope: RoundPerEntry}
+	rounded := rounding.RoundEntries(entries, time.UTC)
+
+	total := 0
+	for _, day := range []string{"2026-03-02", "2026-03-03"} {
+		parts := ClipEntriesToDates(rounded, day, day, time.UTC)
+		if len(parts) != 1 {
+			t.Fatalf("%s: got %d parts, want 1", day, len(parts))
+		}
+		total += *parts[0].Duration
+	}
+	if total != 1800 {
+		t.Errorf("the days add up to %d seconds, want the rounded 1800", total)
+	}
+}
@@ -2,10 +2,74 @@ package models
 
 import "time"
 
+var SupportedDateFormats = []string{"YYYY-MM-DD", "DD.MM.YYYY", "DD/MM/YYYY", "MM/DD/YYYY"}
+
 type Settings struct {
 	ID                  int       `json:"id" db:"id"`
+	WorkspaceID         int       `json:"workspace_id" db:"workspace_id"`
 	DefaultHourlyRate   *float64  `json:"default_hourly_rate" db:"default_hourly_rate"`
 	Currency            string    `json:"currency" db:"currency"`
+	RoundingMode        string    `json:"rounding_mode" db:"rounding_mode"`
+	RoundingMinutes     int       `json:"rounding_minutes" db:"rounding_minutes"`
+	RoundingScope       string    `json:"rounding_scope" db:"rounding_scope"`
+	LockedUntil         *string   `json:"locked_until" db:"locked_until"`
+	Timezone            string    `json:"timezone" db:"timezone"`
+	WeekStartDay        int       `json:"week_start_day" db:"week_start_day"`
+	WorkingHoursPerDay  float64   `json:"working_hours_per_day" db:"working_hours_per_day"`
+	DateFormat          string    `json:"date_format" db:"date_format"`
+	DefaultBillable     bool      `json:"default_billable" db:"default_billable"`
 	CreatedAt           time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+}
+
+// UserSettings holds a user's overrides of the display and time keeping
+// preferences of their workspace settings. Nil fields inherit the workspace
+// value; rates and currency are never overridden per user.
+type UserSettings struct {
+	UserID             int       `json:"user_id" db:"user_id"`
+	Timezone           *string   `json:"timezone" db:"timezone"`
+	WeekStartDay       *int      `json:"week_start_day" db:"week_start_day"`
+	WorkingHoursPerDay *float64  `json:"working_hours_per_day" db:"working_hours_per_day"`
+	DateFormat         *string   `json:"date_format" db:"date_format"`
+	DefaultBillable    *bool     `json:"default_billable" db:"default_billable"`
+	CreatedAt          time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// WithUser returns the workspace settings with the user's overrides applied.
+func (s Settings) WithUser(u UserSettings) Settings {
+	if u.Timezone != nil {
+		s.Timezone = *u.Timezone
+	}
+	if u.WeekStartDay != nil {
+		s.WeekStartDay = *u.WeekStartDay
+	}
+	if u.WorkingHoursPerDay != nil {
+		s.WorkingHoursPerDay = *u.WorkingHoursPerDay
+	}
+	if u.DateFormat != nil {
+		s.DateFormat = *u.DateFormat
+	}
+	if u.DefaultBillable != nil {
+		s.DefaultBillable = *u.DefaultBillable
+	}
+	return s
+}
+
+func IsSupportedDateFormat(format string) bool {
+	for _, supported := range SupportedDateFormats {
+		if format == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Location returns the configured timezone, or UTC when it is unknown.
+func (s Settings) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
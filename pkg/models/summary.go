@@ -0,0 +1,28 @@
+package models
+
+type SummaryTotals struct {
+	Hours         float64 `json:"hours" db:"hours"`
+	BillableHours float64 `json:"billable_hours" db:"billable_hours"`
+	Amount        float64 `json:"amount" db:"amount"`
+}
+
+type SummaryGroup struct {
+	Period      *string `json:"period,omitempty" db:"period"`
+	ProjectID   *int    `json:"project_id,omitempty" db:"project_id"`
+	ProjectName *string `json:"project_name,omitempty" db:"project_name"`
+	ClientID    *int    `json:"client_id,omitempty" db:"client_id"`
+	ClientName  *string `json:"client_name,omitempty" db:"client_name"`
+	TagID       *int    `json:"tag_id,omitempty" db:"tag_id"`
+	TagName     *string `json:"tag_name,omitempty" db:"tag_name"`
+	Billable    *bool   `json:"billable,omitempty" db:"billable"`
+	SummaryTotals
+}
+
+type Summary struct {
+	GroupBy  []string       `json:"group_by"`
+	DateFrom string         `json:"date_from,omitempty"`
+	DateTo   string         `json:"date_to,omitempty"`
+	Currency string         `json:"currency"`
+	Totals   SummaryTotals  `json:"totals"`
+	Groups   []SummaryGroup `json:"groups"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+type Tag struct {
+	ID          int       `json:"id" db:"id"`
+	WorkspaceID int       `json:"workspace_id" db:"workspace_id"`
+	Name        string    `json:"name" db:"name"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
 
 type TimeEntry struct {
 	ID          int        `json:"id" db:"id"`
@@ -11,6 +15,154 @@ type TimeEntry struct {
 	EndTime     *time.Time `json:"end_time" db:"end_time"`
 	Duration    *int       `json:"duration" db:"duration"`
 	Billable    bool       `json:"billable" db:"billable"`
+	Tags        []string   `json:"tags,omitempty" db:"-"`
 	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+}
+
+// LocalizeEntries returns copies of entries with their start and end times in
+// loc, so dates and clock times read as they do for the user.
+func LocalizeEntries(entries []TimeEntry, loc *time.Location) []TimeEntry {
+	localized := make([]TimeEntry, len(entries))
+	for i, entry := range entries {
+		entry.StartTime = entry.StartTime.In(loc)
+		if entry.EndTime != nil {
+			end := entry.EndTime.In(loc)
+			entry.EndTime = &end
+		}
+		localized[i] = entry
+	}
+	return localized
+}
+
+const (
+	EntrySplit = "split"
+	EntryMerge = "merge"
+)
+
+// TimeEntryHistory records a split or merge with the entries as they were
+// before and after it.
+type TimeEntryHistory struct {
+	ID        int             `json:"id" db:"id"`
+	Action    string          `json:"action" db:"action"`
+	Before    json.RawMessage `json:"before" db:"entries_before"`
+	After     json.RawMessage `json:"after" db:"entries_after"`
+	UserID    *int            `json:"user_id" db:"user_id"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+// SplitAt cuts a finished entry at the given points in time, which must be
+// in order and lie between its start and end. Every part keeps the entry's
+// other fields and gets the share of the duration that matches its length,
+// so the parts add up to the entry.
+func SplitAt(entry TimeEntry, points []time.Time) []TimeEntry {
+	if entry.EndTime == nil || entry.Duration == nil || !entry.EndTime.After(entry.StartTime) {
+		return []TimeEntry{entry}
+	}
+
+	start, end := entry.StartTime, *entry.EndTime
+	span := float64(end.Sub(start))
+	bounds := append(append([]time.Time{}, points...), end)
+	var parts []TimeEntry
+	allocated := 0
+	partStart := start
+	for _, partEnd := range bounds {
+		partEnd := partEnd.In(start.Location())
+		next := int(math.Round(float64(partEnd.Sub(start)) * float64(*entry.Duration) / span))
+		duration := next - allocated
+		allocated = next
+
+		part := entry
+		part.StartTime = partStart
+		part.EndTime = &partEnd
+		part.Duration = &duration
+		parts = append(parts, part)
+		partStart = partEnd
+	}
+	return parts
+}
+
+// SplitInto cuts a finished entry into n parts of equal length.
+func SplitInto(entry TimeEntry, n int) []TimeEntry {
+	if entry.EndTime == nil || n < 2 {
+		return []TimeEntry{entry}
+	}
+
+	step := entry.EndTime.Sub(entry.StartTime) / time.Duration(n)
+	points := make([]time.Time, n-1)
+	for i := range points {
+		points[i] = entry.StartTime.Add(step * time.Duration(i+1))
+	}
+	return SplitAt(entry, points)
+}
+
+// SplitAtMidnight returns the parts of a finished entry that fall on each
+// calendar day in loc, cut with SplitAt. Running entries and entries within a
+// single day are returned unchanged.
+func SplitAtMidnight(entry TimeEntry, loc *time.Location) []TimeEntry {
+	if entry.EndTime == nil || entry.Duration == nil {
+		return []TimeEntry{entry}
+	}
+
+	var points []time.Time
+	for local := entry.StartTime.In(loc); ; {
+		midnight := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+		if !midnight.Before(*entry.EndTime) {
+			break
+		}
+		points = append(points, midnight)
+		local = midnight
+	}
+	return SplitAt(entry, points)
+}
+
+// SplitEntriesAtMidnight splits every entry of entries with SplitAtMidnight.
+func SplitEntriesAtMidnight(entries []TimeEntry, loc *time.Location) []TimeEntry {
+	split := make([]TimeEntry, 0, len(entries))
+	for _, entry := range entries {
+		split = append(split, SplitAtMidnight(entry, loc)...)
+	}
+	return split
+}
+
+// ClipEntriesToDates returns the entries that fall on the calendar days from
+// dateFrom to dateTo (YYYY-MM-DD, inclusive, empty for no limit) in loc.
+// Entries crossing the start or end of the range keep only their parts inside
+// it.
+func ClipEntriesToDates(entries []TimeEntry, dateFrom, dateTo string, loc *time.Location) []TimeEntry {
+	inRange := func(t time.Time) bool {
+		date := t.In(loc).Format("2006-01-02")
+		return (dateFrom == "" || date >= dateFrom) && (dateTo == "" || date <= dateTo)
+	}
+
+	clipped := make([]TimeEntry, 0, len(entries))
+	for _, entry := range entries {
+		parts := SplitAtMidnight(entry, loc)
+		if len(parts) == 1 || (inRange(parts[0].StartTime) && inRange(parts[len(parts)-1].StartTime)) {
+			if inRange(entry.StartTime) {
+				clipped = append(clipped, entry)
+			}
+			continue
+		}
+		for _, part := range parts {
+			if inRange(part.StartTime) {
+				clipped = append(clipped, part)
+			}
+		}
+	}
+	return clipped
+}
+
+const (
+	BulkUpdated  = "updated"
+	BulkDeleted  = "deleted"
+	BulkNotFound = "not_found"
+	BulkFailed   = "failed"
+)
+
+// BulkResult reports what a bulk operation did to one time entry.
+type BulkResult struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
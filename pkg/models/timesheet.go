@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// TimesheetRow holds a project's hours for each day of a timesheet week,
+// starting on the user's first day of the week.
+type TimesheetRow struct {
+	ProjectID   int       `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	Hours       []float64 `json:"hours"`
+	Total       float64   `json:"total"`
+}
+
+type Timesheet struct {
+	UserID    int            `json:"user_id"`
+	WeekStart string         `json:"week_start"`
+	Days      []string       `json:"days"`
+	Rows      []TimesheetRow `json:"rows"`
+	DayTotals []float64      `json:"day_totals"`
+	Total     float64        `json:"total"`
+}
+
+const (
+	TimesheetOpen      = "open"
+	TimesheetSubmitted = "submitted"
+	TimesheetApproved  = "approved"
+	TimesheetRejected  = "rejected"
+)
+
+type TimesheetPeriod struct {
+	ID          int              `json:"id" db:"id"`
+	UserID      int              `json:"user_id" db:"user_id"`
+	WeekStart   string           `json:"week_start" db:"week_start"`
+	Status      string           `json:"status" db:"status"`
+	Comment     string           `json:"comment" db:"comment"`
+	SubmittedAt *time.Time       `json:"submitted_at" db:"submitted_at"`
+	ReviewedAt  *time.Time       `json:"reviewed_at" db:"reviewed_at"`
+	ReviewerID  *int             `json:"reviewer_id" db:"reviewer_id"`
+	Events      []TimesheetEvent `json:"events,omitempty" db:"-"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+type TimesheetEvent struct {
+	ID        int       `json:"id" db:"id"`
+	PeriodID  int       `json:"period_id" db:"period_id"`
+	Status    string    `json:"status" db:"status"`
+	Comment   string    `json:"comment" db:"comment"`
+	UserID    *int      `json:"user_id" db:"user_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
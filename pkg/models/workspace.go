@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+var roleRanks = map[string]int{
+	RoleViewer: 1,
+	RoleMember: 2,
+	RoleAdmin:  3,
+	RoleOwner:  4,
+}
+
+func IsSupportedRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// RoleAtLeast reports whether role grants at least the permissions of
+// minimum. Unknown roles, including no role at all, grant nothing.
+func RoleAtLeast(role, minimum string) bool {
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[minimum]
+}
+
+type Workspace struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Role      string    `json:"role,omitempty" db:"role"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID int       `json:"workspace_id" db:"workspace_id"`
+	UserID      int       `json:"user_id" db:"user_id"`
+	Role        string    `json:"role" db:"role"`
+	Name        string    `json:"name" db:"name"`
+	Email       string    `json:"email" db:"email"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type ProjectMember struct {
+	ProjectID int       `json:"project_id" db:"project_id"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	Name      string    `json:"name" db:"name"`
+	Email     string    `json:"email" db:"email"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
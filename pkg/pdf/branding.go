@@ -0,0 +1,70 @@
+package pdf
+
+import (
+	"bytes"
+	"strconv"
+
+	"side-sync/pkg/models"
+
+	"github.com/jung-kurt/gofpdf/v2"
+)
+
+const defaultFooterText = "Side Sync Time Tracking"
+
+func primaryColor(branding models.Branding) (int, int, int) {
+	color := branding.PrimaryColor
+	if len(color) == 7 && color[0] == '#' {
+		value, err := strconv.ParseUint(color[1:], 16, 32)
+		if err == nil {
+			return int(value >> 16 & 0xff), int(value >> 8 & 0xff), int(value & 0xff)
+		}
+	}
+	return 52, 152, 219
+}
+
+func footerText(branding models.Branding) string {
+	if branding.FooterText != "" {
+		return branding.FooterText
+	}
+	return defaultFooterText
+}
+
+func (g *Generator) addLetterhead(pdf *document, branding models.Branding) {
+	left, top, _, _ := pdf.GetMargins()
+
+	if len(branding.Logo) > 0 {
+		imageType := "PNG"
+		if branding.LogoContentType == "image/jpeg" {
+			imageType = "JPG"
+		}
+		options := gofpdf.ImageOptions{ImageType: imageType}
+		info := pdf.RegisterImageOptionsReader("logo", options, bytes.NewReader(branding.Logo))
+		if info != nil && info.Height() > 0 {
+			height := 15.0
+			width := height * info.Width() / info.Height()
+			if width > 60 {
+				width = 60
+				height = width * info.Height() / info.Width()
+			}
+			pdf.ImageOptions("logo", 200-width, top, width, height, false, options, 0, "")
+		}
+	}
+
+	if branding.CompanyName == "" && branding.CompanyAddress == "" {
+		return
+	}
+
+	pdf.SetXY(left, top)
+	pdf.SetTextColor(0, 0, 0)
+	if branding.CompanyName != "" {
+		pdf.SetFont(fontFamily, "B", 11)
+		pdf.Cell(120, 5, fitText(pdf, branding.CompanyName, 120))
+		pdf.Ln(5)
+	}
+	if branding.CompanyAddress != "" {
+		pdf.SetFont(fontFamily, "", 9)
+		pdf.SetTextColor(100, 100, 100)
+		pdf.MultiCell(120, 4, branding.CompanyAddress, "", "L", false)
+	}
+	pdf.Ln(4)
+}
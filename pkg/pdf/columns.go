@@ -0,0 +1,219 @@
+package pdf
+
+import (
+	"strings"
+
+	"side-sync/pkg/models"
+)
+
+const (
+	ColumnDate        = "date"
+	ColumnStart       = "start"
+	ColumnEnd         = "end"
+	ColumnDescription = "description"
+	ColumnTags        = "tags"
+	ColumnDuration    = "duration"
+	ColumnBillable    = "billable"
+	ColumnHours       = "hours"
+	ColumnRate        = "rate"
+	ColumnCost        = "cost"
+)
+
+const tableWidth = 190.0
+
+type columnDef struct {
+	width float64
+	// aggregate columns hold figures that are summed up in group rows.
+	aggregate bool
+	// pricing columns are only shown when the report includes amounts.
+	pricing bool
+}
+
+var columnDefs = map[string]columnDef{
+	ColumnDate:        {width: 20},
+	ColumnStart:       {width: 12},
+	ColumnEnd:         {width: 12},
+	ColumnDescription: {},
+	ColumnTags:        {width: 26},
+	ColumnDuration:    {width: 17, aggregate: true},
+	ColumnBillable:    {width: 16, aggregate: true},
+	ColumnHours:       {width: 15, aggregate: true},
+	ColumnRate:        {width: 18, pricing: true},
+	ColumnCost:        {width: 22, aggregate: true, pricing: true},
+}
+
+var DefaultColumns = []string{
+	ColumnDate,
+	ColumnDescription,
+	ColumnDuration,
+	ColumnBillable,
+	ColumnHours,
+	ColumnRate,
+	ColumnCost,
+}
+
+type tableColumn struct {
+	key       string
+	width     float64
+	aggregate bool
+}
+
+// rowValues holds the figures shown for an entry or a group of entries.
+type rowValues struct {
+	seconds       int
+	hours         float64
+	billableHours float64
+	cost          float64
+}
+
+func IsSupportedColumn(key string) bool {
+	_, ok := columnDefs[key]
+	return ok
+}
+
+// layoutColumns resolves the selected columns to their widths on the page.
+// Pricing columns are dropped when amounts are not shown. The description
+// column takes up the remaining width; without it, the columns are stretched
+// to fill the table.
+func layoutColumns(keys []string, withPricing bool) []tableColumn {
+	if len(keys) == 0 {
+		keys = DefaultColumns
+	}
+
+	var columns []tableColumn
+	var fixedWidth float64
+	flexible := -1
+	for _, key := range keys {
+		def, ok := columnDefs[key]
+		if !ok || (def.pricing && !withPricing) {
+			continue
+		}
+		if key == ColumnDescription {
+			flexible = len(columns)
+		}
+		columns = append(columns, tableColumn{key: key, width: def.width, aggregate: def.aggregate})
+		fixedWidth += def.width
+	}
+
+	if flexible >= 0 {
+		columns[flexible].width = tableWidth - fixedWidth
+	} else if fixedWidth > 0 {
+		for i := range columns {
+			columns[i].width *= tableWidth / fixedWidth
+		}
+	}
+
+	return columns
+}
+
+func sumEntryValues(entries []models.TimeEntry, effectiveRate float64) rowValues {
+	var total rowValues
+	for _, entry := range entries {
+		values := entryValues(entry, effectiveRate)
+		total.seconds += values.seconds
+		total.hours += values.hours
+		total.billableHours += values.billableHours
+		total.cost += values.cost
+	}
+	return total
+}
+
+func entryValues(entry models.TimeEntry, effectiveRate float64) rowValues {
+	var values rowValues
+	if entry.Duration == nil {
+		return values
+	}
+
+	values.seconds = *entry.Duration
+	values.hours = float64(values.seconds) / 3600
+	if entry.Billable {
+		values.billableHours = values.hours
+		values.cost = values.hours * effectiveRate
+	}
+	return values
+}
+
+func (g *Generator) addColumnHeaders(pdf *document, loc *Locale, columns []tableColumn) {
+	for _, column := range columns {
+		pdf.Cell(column.width, 8, fitText(pdf, loc.T(column.key), column.width))
+	}
+}
+
+func (g *Generator) addEntryCells(pdf *document, loc *Locale, columns []tableColumn, entry models.TimeEntry, values rowValues, effectiveRate float64) {
+	for _, column := range columns {
+		var text string
+		switch column.key {
+		case ColumnDate:
+			text = loc.Date(entry.StartTime)
+		case ColumnStart:
+			text = entry.StartTime.Format("15:04")
+		case ColumnEnd:
+			text = "-"
+			if entry.EndTime != nil {
+				text = entry.EndTime.Format("15:04")
+			}
+		case ColumnDescription:
+			text = entry.Description
+		case ColumnTags:
+			text = strings.Join(entry.Tags, ", ")
+		case ColumnDuration:
+			text = formatDurationSeconds(&values.seconds)
+		case ColumnBillable:
+			text = loc.T("no")
+			if entry.Billable {
+				text = loc.T("yes")
+			}
+		case ColumnHours:
+			text = loc.FormatHours(values.hours)
+		case ColumnRate:
+			text = "-"
+			if entry.Billable {
+				text = loc.FormatAmount(effectiveRate)
+			}
+		case ColumnCost:
+			text = "-"
+			if entry.Billable {
+				text = loc.FormatAmount(values.cost)
+			}
+		}
+		pdf.Cell(column.width, 6, fitText(pdf, text, column.width))
+	}
+}
+
+// addMergedCells draws a row in which every run of non-aggregate columns is
+// merged into a single cell. The first merged cell holds the label, the
+// aggregate columns hold the values returned by value.
+func (g *Generator) addMergedCells(pdf *document, columns []tableColumn, height float64, label string, value func(key string) string) {
+	var width float64
+	flush := func() {
+		if width > 0 {
+			pdf.Cell(width, height, fitText(pdf, label, width))
+			label = ""
+			width = 0
+		}
+	}
+
+	for _, column := range columns {
+		if !column.aggregate {
+			width += column.width
+			continue
+		}
+		flush()
+		pdf.Cell(column.width, height, fitText(pdf, value(column.key), column.width))
+	}
+	flush()
+}
+
+func groupCell(loc *Locale, key string, values rowValues) string {
+	switch key {
+	case ColumnDuration:
+		return formatDurationSeconds(&values.seconds)
+	case ColumnBillable:
+		return loc.FormatHours(values.billableHours)
+	case ColumnHours:
+		return loc.FormatHours(values.hours)
+	case ColumnCost:
+		return loc.FormatAmount(values.cost)
+	}
+	return ""
+}
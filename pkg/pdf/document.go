@@ -0,0 +1,101 @@
+package pdf
+
+import (
+	"time"
+
+	"side-sync/pkg/models"
+
+	"github.com/jung-kurt/gofpdf/v2"
+)
+
+const (
+	pageTop    = 15.0
+	pageBottom = 276.0
+	rowHeight  = 6.0
+)
+
+// document wraps a gofpdf document with the state its page header and footer
+// callbacks need: the report locale and branding, and the header of the table
+// currently being drawn so it can be repeated after a page break.
+type document struct {
+	*gofpdf.Fpdf
+	loc         *Locale
+	branding    models.Branding
+	generatedAt time.Time
+	tableHeader func()
+}
+
+func (g *Generator) newDocument(branding models.Branding, loc *Locale, tz *time.Location) *document {
+	doc := &document{
+		Fpdf:        gofpdf.New("P", "mm", "A4", ""),
+		loc:         loc,
+		branding:    branding,
+		generatedAt: time.Now().In(tz),
+	}
+
+	// The page count alias has to be set before the fonts are registered so
+	// the digits it is replaced with are included in the font subsets.
+	doc.AliasNbPages("")
+	g.registerFonts(doc.Fpdf)
+
+	doc.SetAutoPageBreak(true, 297-pageBottom)
+	doc.SetHeaderFunc(doc.pageHeader)
+	doc.SetFooterFunc(doc.pageFooter)
+
+	return doc
+}
+
+func (d *document) pageHeader() {
+	if d.PageNo() == 1 {
+		return
+	}
+
+	d.SetXY(10, pageTop)
+	if d.tableHeader != nil {
+		d.tableHeader()
+	}
+}
+
+func (d *document) pageFooter() {
+	d.SetFont(fontFamily, "I", 8)
+	d.SetTextColor(150, 150, 150)
+	d.SetXY(10, -15)
+
+	generated := d.loc.Tf("generated_on", d.loc.DateTime(d.generatedAt)) + " | " + footerText(d.branding)
+	d.CellFormat(150, 4, fitText(d, generated, 150), "", 0, "L", false, 0, "")
+	d.CellFormat(40, 4, d.loc.Tf("page_of", d.PageNo(), "{nb}"), "", 0, "R", false, 0, "")
+}
+
+// ensureSpace starts a new page when a block of the given height would not
+// fit below y, so summaries and group headers are never split from the rows
+// that follow them. It returns the y position to draw the block at.
+func (d *document) ensureSpace(y, height float64) float64 {
+	if y+height > pageBottom {
+		d.AddPage()
+		y = d.GetY()
+	}
+	d.SetXY(10, y)
+	return y
+}
+
+func (d *document) nextRow(y float64) float64 {
+	return d.ensureSpace(y, rowHeight)
+}
+
+// beginTable draws a table header at y and registers it to be repeated at the
+// top of every page until endTable is called. It returns the y position of the
+// first row.
+func (d *document) beginTable(y float64, drawHeader func()) float64 {
+	d.tableHeader = func() {
+		drawHeader()
+		d.SetXY(10, d.GetY()+8)
+	}
+
+	d.SetXY(10, y)
+	d.tableHeader()
+	return d.GetY()
+}
+
+func (d *document) endTable() {
+	d.tableHeader = nil
+}
@@ -0,0 +1,78 @@
+package pdf
+
+import (
+	"embed"
+	"os"
+
+	"github.com/jung-kurt/gofpdf/v2"
+)
+
+//go:embed fonts/*.ttf
+var bundledFonts embed.FS
+
+const fontFamily = "DejaVu"
+
+type FontConfig struct {
+	Regular    string
+	Bold       string
+	Italic     string
+	BoldItalic string
+}
+
+func FontConfigFromEnv() FontConfig {
+	return FontConfig{
+		Regular:    os.Getenv("PDF_FONT_REGULAR"),
+		Bold:       os.Getenv("PDF_FONT_BOLD"),
+		Italic:     os.Getenv("PDF_FONT_ITALIC"),
+		BoldItalic: os.Getenv("PDF_FONT_BOLD_ITALIC"),
+	}
+}
+
+func (g *Generator) registerFonts(pdf *gofpdf.Fpdf) {
+	styles := []struct {
+		style   string
+		path    string
+		bundled string
+	}{
+		{"", g.fonts.Regular, "fonts/DejaVuSansCondensed.ttf"},
+		{"B", g.fonts.Bold, "fonts/DejaVuSansCondensed-Bold.ttf"},
+		{"I", g.fonts.Italic, "fonts/DejaVuSansCondensed-Oblique.ttf"},
+		{"BI", g.fonts.BoldItalic, "fonts/DejaVuSansCondensed-BoldOblique.ttf"},
+	}
+
+	for _, s := range styles {
+		var data []byte
+		var err error
+		if s.path != "" {
+			data, err = os.ReadFile(s.path)
+		} else {
+			data, err = bundledFonts.ReadFile(s.bundled)
+		}
+		if err != nil {
+			pdf.SetError(err)
+			return
+		}
+		pdf.AddUTF8FontFromBytes(fontFamily, s.style, data)
+	}
+}
+
+// fitText shortens text so it fits into a cell of the given width with the
+// current font, cutting on rune boundaries and appending an ellipsis.
+func fitText(pdf *document, text string, width float64) string {
+	const ellipsis = "..."
+	available := width - 1
+	if pdf.GetStringWidth(text) <= available {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + ellipsis
+		if pdf.GetStringWidth(candidate) <= available {
+			return candidate
+		}
+	}
+
+	return ellipsis
+}
@@ -7,11 +7,11 @@ import (
 	"time"
 
 	"side-sync/pkg/models"
-
-	"github.com/jung-kurt/gofpdf/v2"
 )
 
-type Generator struct{}
+type Generator struct {
+	fonts FontConfig
+}
 
 type ReportConfig struct {
 	Project        models.Project
@@ -21,32 +21,33 @@ type ReportConfig struct {
 	DateFrom       string
 	DateTo         string
 	BillableFilter string
+	Branding       models.Branding
+	GroupBy        string
+	Collapse       bool
+	Locale         string
+	Columns        []string
 }
 
 func NewGenerator() *Generator {
-	return &Generator{}
-}
-
-func formatAmount(amount float64) string {
-	return fmt.Sprintf("%.2f", amount)
-}
-
-func formatCurrency(amount float64, currencyCode string) string {
-	return fmt.Sprintf("%.2f %s", amount, currencyCode)
+	return &Generator{fonts: FontConfigFromEnv()}
 }
 
+// GenerateTimeReport renders the report with dates and times in the timezone
+// of config.Settings.
 func (g *Generator) GenerateTimeReport(config ReportConfig) (*bytes.Buffer, error) {
-	pdf := gofpdf.New("P", "mm", "A4", "")
+	loc := lookupLocale(config.Locale)
+	tz := config.Settings.Location()
+	config.TimeEntries = models.LocalizeEntries(config.TimeEntries, tz)
+
+	pdf := g.newDocument(config.Branding, loc, tz)
 	pdf.AddPage()
 
-	g.addHeader(pdf, config.Project)
+	g.addHeader(pdf, config.Project, config.Branding, loc)
 
 	totalHours, billableHours, _, billableCost, effectiveRate, currency := g.calculateTotals(config)
 	g.addSummary(pdf, config, totalHours, billableHours, billableCost, effectiveRate, currency)
 
-	g.addTimeEntriesTable(pdf, config, effectiveRate, currency)
-
-	g.addFooter(pdf)
+	g.addTimeEntriesTable(pdf, config, effectiveRate, currency, loc.T("time_entries"))
 
 	var buf bytes.Buffer
 	err := pdf.Output(&buf)
@@ -57,31 +58,32 @@ func (g *Generator) GenerateTimeReport(config ReportConfig) (*bytes.Buffer, erro
 	return &buf, nil
 }
 
-func (g *Generator) addHeader(pdf *gofpdf.Fpdf, project models.Project) {
-	pdf.SetFont("Arial", "B", 20)
-	pdf.SetTextColor(52, 152, 219)
-	pdf.Cell(190, 15, "TIME TRACKING REPORT")
+func (g *Generator) addHeader(pdf *document, project models.Project, branding models.Branding, loc *Locale) {
+	g.addLetterhead(pdf, branding)
+
+	pdf.SetFont(fontFamily, "B", 20)
+	pdf.SetTextColor(primaryColor(branding))
+	pdf.Cell(190, 15, loc.T("title"))
 	pdf.Ln(12)
 
-	pdf.SetFont("Arial", "B", 16)
+	pdf.SetFont(fontFamily, "B", 16)
 	pdf.SetTextColor(0, 0, 0)
-	pdf.Cell(190, 10, fmt.Sprintf("Project: %s", project.Name))
+	pdf.Cell(190, 10, fitText(pdf, fmt.Sprintf("%s: %s", loc.T("project"), project.Name), 190))
 	pdf.Ln(10)
 
 	if project.Description != "" {
-		pdf.SetFont("Arial", "", 12)
+		pdf.SetFont(fontFamily, "", 12)
 		pdf.SetTextColor(100, 100, 100)
-		pdf.Cell(190, 6, project.Description)
+		pdf.Cell(190, 6, fitText(pdf, project.Description, 190))
 		pdf.Ln(10)
 	}
 
-	pdf.SetFont("Arial", "", 10)
+	pdf.SetFont(fontFamily, "", 10)
 	pdf.SetTextColor(150, 150, 150)
-	pdf.Cell(190, 5, fmt.Sprintf("Generated on %s", time.Now().Format("January 2, 2006 at 3:04 PM")))
+	pdf.Cell(190, 5, loc.Tf("generated_on", loc.DateTime(pdf.generatedAt)))
 }
 
 func (g *Generator) calculateTotals(config ReportConfig) (float64, float64, float64, float64, float64, string) {
-	var totalHours, billableHours, totalCost, billableCost float64
 	var effectiveRate float64
 	currency := "EUR"
 
@@ -95,212 +97,119 @@ func (g *Generator) calculateTotals(config ReportConfig) (float64, float64, floa
 		currency = config.Settings.Currency
 	}
 
-	for _, entry := range config.TimeEntries {
-		if entry.Duration != nil {
-			hours := float64(*entry.Duration) / 3600
-			totalHours += hours
-			if entry.Billable {
-				billableHours += hours
-				billableCost += hours * effectiveRate
-			}
-			totalCost += hours * effectiveRate
-		}
-	}
-
-	return totalHours, billableHours, totalCost, billableCost, effectiveRate, currency
+	totals := sumEntryValues(config.TimeEntries, effectiveRate)
+	return totals.hours, totals.billableHours, totals.hours * effectiveRate, totals.cost, effectiveRate, currency
 }
 
-func (g *Generator) addSummary(pdf *gofpdf.Fpdf, config ReportConfig, totalHours, billableHours, billableCost, effectiveRate float64, currency string) {
+func (g *Generator) addSummary(pdf *document, config ReportConfig, totalHours, billableHours, billableCost, effectiveRate float64, currency string) {
+	loc := lookupLocale(config.Locale)
 	_, currentY := pdf.GetXY()
-	summaryY := currentY + 8
+	summaryY := pdf.ensureSpace(currentY+8, 45)
 
-	pdf.SetFont("Arial", "B", 14)
+	pdf.SetFont(fontFamily, "B", 14)
 	pdf.SetXY(10, summaryY)
-	pdf.Cell(190, 8, "SUMMARY")
+	pdf.Cell(190, 8, loc.T("summary"))
 
 	rateY := summaryY + 12
 	if effectiveRate > 0 && config.IncludePricing {
-		pdf.SetFont("Arial", "", 10)
+		pdf.SetFont(fontFamily, "", 10)
 		pdf.SetXY(10, rateY)
-		pdf.Cell(190, 5, fmt.Sprintf("Rate: %s/hr - All amounts in %s", formatAmount(effectiveRate), currency))
+		pdf.Cell(190, 5, loc.Tf("rate_line", loc.FormatCurrency(effectiveRate, currency), currency))
 		rateY += 8
 	}
 
-	pdf.SetFont("Arial", "B", 12)
-	pdf.SetFillColor(240, 248, 255)
+	g.addSummaryBoxes(pdf, loc, rateY+5, totalHours, billableHours, billableCost, effectiveRate > 0 && config.IncludePricing, currency)
+}
 
-	yPos := rateY + 5
+func (g *Generator) addSummaryBoxes(pdf *document, loc *Locale, yPos, totalHours, billableHours, billableCost float64, showAmount bool, currency string) {
+	pdf.SetFont(fontFamily, "B", 12)
+	pdf.SetFillColor(240, 248, 255)
 
 	pdf.Rect(10, float64(yPos), 40, 20, "F")
 	pdf.SetXY(12, float64(yPos+3))
-	pdf.Cell(35, 5, "Total Hours")
-	pdf.SetFont("Arial", "B", 14)
+	pdf.Cell(35, 5, fitText(pdf, loc.T("total_hours"), 37))
+	pdf.SetFont(fontFamily, "B", 14)
 	pdf.SetXY(12, float64(yPos+10))
-	pdf.Cell(35, 5, fmt.Sprintf("%.1f", totalHours))
+	pdf.Cell(35, 5, loc.FormatHours(totalHours))
 
-	pdf.SetFont("Arial", "B", 12)
+	pdf.SetFont(fontFamily, "B", 12)
 	pdf.Rect(60, float64(yPos), 40, 20, "F")
 	pdf.SetXY(62, float64(yPos+3))
-	pdf.Cell(35, 5, "Billable Hours")
-	pdf.SetFont("Arial", "B", 14)
+	pdf.Cell(35, 5, fitText(pdf, loc.T("billable_hours"), 37))
+	pdf.SetFont(fontFamily, "B", 14)
 	pdf.SetXY(62, float64(yPos+10))
-	pdf.Cell(35, 5, fmt.Sprintf("%.1f", billableHours))
+	pdf.Cell(35, 5, loc.FormatHours(billableHours))
 
-	pdf.SetFont("Arial", "B", 12)
+	pdf.SetFont(fontFamily, "B", 12)
 	pdf.Rect(110, float64(yPos), 40, 20, "F")
 	pdf.SetXY(112, float64(yPos+3))
-	pdf.Cell(40, 5, "Non-Billable Hours")
-	pdf.SetFont("Arial", "B", 14)
+	pdf.Cell(40, 5, fitText(pdf, loc.T("non_billable_hours"), 37))
+	pdf.SetFont(fontFamily, "B", 14)
 	pdf.SetXY(112, float64(yPos+10))
-	pdf.Cell(40, 5, fmt.Sprintf("%.1f", totalHours-billableHours))
+	pdf.Cell(40, 5, loc.FormatHours(totalHours-billableHours))
 
-	if effectiveRate > 0 && config.IncludePricing {
-		pdf.SetFont("Arial", "B", 12)
+	if showAmount {
+		pdf.SetFont(fontFamily, "B", 12)
 		pdf.Rect(160, float64(yPos), 40, 20, "F")
 		pdf.SetXY(162, float64(yPos+3))
-		pdf.Cell(35, 5, "Billable Amount")
-		pdf.SetFont("Arial", "B", 14)
+		pdf.Cell(35, 5, fitText(pdf, loc.T("billable_amount"), 37))
+		pdf.SetFont(fontFamily, "B", 14)
 		pdf.SetXY(162, float64(yPos+10))
-		pdf.Cell(35, 5, formatCurrency(billableCost, currency))
+		pdf.Cell(35, 5, fitText(pdf, loc.FormatCurrency(billableCost, currency), 37))
 	}
 }
 
-func (g *Generator) addTimeEntriesTable(pdf *gofpdf.Fpdf, config ReportConfig, effectiveRate float64, currency string) {
+func (g *Generator) addTimeEntriesTable(pdf *document, config ReportConfig, effectiveRate float64, currency string, title string) {
+	loc := lookupLocale(config.Locale)
 	_, currentY := pdf.GetXY()
-	tableY := currentY + 10
+	tableY := pdf.ensureSpace(currentY+10, 18+2*rowHeight)
 
-	pdf.SetFont("Arial", "B", 14)
+	pdf.SetFont(fontFamily, "B", 14)
 	pdf.SetXY(10, tableY)
-	pdf.Cell(190, 8, "TIME ENTRIES")
-
-	pdf.SetFillColor(52, 152, 219)
-	pdf.SetTextColor(255, 255, 255)
-	pdf.SetFont("Arial", "B", 9)
+	pdf.Cell(190, 8, fitText(pdf, title, 190))
+
+	columns := layoutColumns(config.Columns, effectiveRate > 0 && config.IncludePricing)
+
+	y := pdf.beginTable(tableY+10, func() {
+		headerY := pdf.GetY()
+		pdf.SetFillColor(primaryColor(config.Branding))
+		pdf.SetTextColor(255, 255, 255)
+		pdf.SetFont(fontFamily, "B", 9)
+		pdf.Rect(10, headerY, 190, 8, "F")
+		pdf.SetXY(10, headerY)
+
+		if config.GroupBy != "" && config.Collapse {
+			g.addMergedCells(pdf, columns, 8, loc.T(config.GroupBy), func(key string) string {
+				return loc.T(key)
+			})
+		} else {
+			g.addColumnHeaders(pdf, loc, columns)
+		}
+	})
+	defer pdf.endTable()
 
-	headerY := tableY + 10
-	pdf.SetXY(10, float64(headerY))
-	pdf.Rect(10, float64(headerY), 190, 8, "F")
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont(fontFamily, "", 8)
 
-	if effectiveRate > 0 && config.IncludePricing {
-		pdf.Cell(22, 8, "Date")
-		pdf.Cell(65, 8, "Description")
-		pdf.Cell(22, 8, "Duration")
-		pdf.Cell(18, 8, "Billable")
-		pdf.Cell(18, 8, "Hours")
-		pdf.Cell(22, 8, "Rate")
-		pdf.Cell(23, 8, "Cost")
-	} else {
-		pdf.Cell(30, 8, "Date")
-		pdf.Cell(80, 8, "Description")
-		pdf.Cell(30, 8, "Duration")
-		pdf.Cell(25, 8, "Billable")
-		pdf.Cell(25, 8, "Hours")
+	if config.GroupBy != "" {
+		g.addGroupedRows(pdf, config, columns, effectiveRate, y)
+		return
 	}
 
-	pdf.SetTextColor(0, 0, 0)
-	pdf.SetFont("Arial", "", 8)
-	y := headerY + 8
-
 	for _, entry := range config.TimeEntries {
-		pdf.SetXY(10, float64(y))
-
-		if y > 270 {
-			pdf.AddPage()
-			y = 20
-			pdf.SetXY(10, float64(y))
-		}
-
-		var entryHours float64
-		var entryCost float64
-		if entry.Duration != nil {
-			entryHours = float64(*entry.Duration) / 3600
-			if effectiveRate > 0 {
-				entryCost = entryHours * effectiveRate
-			}
-		}
-
-		if effectiveRate > 0 && config.IncludePricing {
-			date := entry.StartTime.Format("01-02")
-			pdf.Cell(22, 6, date)
-
-			description := entry.Description
-			if len(description) > 32 {
-				description = description[:29] + "..."
-			}
-			pdf.Cell(65, 6, description)
-
-			var durationText string
-			if entry.Duration != nil {
-				hours := *entry.Duration / 3600
-				minutes := (*entry.Duration % 3600) / 60
-				durationText = fmt.Sprintf("%02d:%02d", hours, minutes)
-			} else {
-				durationText = "00:00"
-			}
-			pdf.Cell(22, 6, durationText)
-
-			billableText := "No"
-			if entry.Billable {
-				billableText = "Yes"
-			}
-			pdf.Cell(18, 6, billableText)
-
-			pdf.Cell(18, 6, fmt.Sprintf("%.1f", entryHours))
-
-			if entry.Billable {
-				pdf.Cell(22, 6, formatAmount(effectiveRate))
-			} else {
-				pdf.Cell(22, 6, "-")
-			}
-
-			if entry.Billable {
-				pdf.Cell(23, 6, formatAmount(entryCost))
-			} else {
-				pdf.Cell(23, 6, "-")
-			}
-		} else {
-			date := entry.StartTime.Format("2006-01-02")
-			pdf.Cell(30, 6, date)
-
-			description := entry.Description
-			if len(description) > 45 {
-				description = description[:42] + "..."
-			}
-			pdf.Cell(80, 6, description)
-
-			var durationText string
-			if entry.Duration != nil {
-				hours := *entry.Duration / 3600
-				minutes := (*entry.Duration % 3600) / 60
-				durationText = fmt.Sprintf("%02d:%02d", hours, minutes)
-			} else {
-				durationText = "00:00"
-			}
-			pdf.Cell(30, 6, durationText)
-
-			billableText := "No"
-			if entry.Billable {
-				billableText = "Yes"
-			}
-			pdf.Cell(25, 6, billableText)
-
-			pdf.Cell(25, 6, fmt.Sprintf("%.1f", entryHours))
-		}
-
-		y += 6
+		y = pdf.nextRow(y)
+		g.addEntryCells(pdf, loc, columns, entry, entryValues(entry, effectiveRate), effectiveRate)
+		y += rowHeight
 	}
 }
 
-func (g *Generator) addFooter(pdf *gofpdf.Fpdf) {
-	_, y := pdf.GetXY()
-
-	footerY := y + 20
-	if footerY > 280 {
-		footerY = 280
+func formatDurationSeconds(duration *int) string {
+	if duration == nil {
+		return "00:00"
 	}
-	pdf.SetFont("Arial", "I", 8)
-	pdf.SetXY(10, footerY)
-	pdf.Cell(190, 4, fmt.Sprintf("Generated on %s | Side Sync Time Tracking", time.Now().Format("January 2, 2006 at 3:04 PM")))
+	hours := *duration / 3600
+	minutes := (*duration % 3600) / 60
+	return fmt.Sprintf("%02d:%02d", hours, minutes)
 }
 
 func (g *Generator) GetFilename(projectName string) string {
@@ -0,0 +1,127 @@
+package pdf
+
+import (
+	"fmt"
+	"sort"
+
+	"side-sync/pkg/models"
+)
+
+const (
+	GroupByDay  = "day"
+	GroupByWeek = "week"
+	GroupByTask = "task"
+	GroupByTag  = "tag"
+)
+
+var groupKeys = map[string]bool{
+	GroupByDay:  true,
+	GroupByWeek: true,
+	GroupByTask: true,
+	GroupByTag:  true,
+}
+
+type entryGroup struct {
+	label   string
+	entries []models.TimeEntry
+}
+
+func IsSupportedGroupBy(groupBy string) bool {
+	return groupBy == "" || groupKeys[groupBy]
+}
+
+// groupTimeEntries splits entries into groups in report order. Day and week
+// groups follow the entries' chronological order, task and tag groups are
+// sorted by label. An entry with several tags appears in each of its tags.
+// Entries crossing midnight are split so each day and week only gets the
+// time that fell on it; they are expected in the report's timezone.
+func groupTimeEntries(entries []models.TimeEntry, groupBy string, loc *Locale) []entryGroup {
+	if groupBy == GroupByDay || groupBy == GroupByWeek {
+		var split []models.TimeEntry
+		for _, entry := range entries {
+			split = append(split, models.SplitAtMidnight(entry, entry.StartTime.Location())...)
+		}
+		entries = split
+	}
+
+	var groups []entryGroup
+	index := make(map[string]int)
+
+	add := func(key, label string, entry models.TimeEntry) {
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, entryGroup{label: label})
+		}
+		groups[i].entries = append(groups[i].entries, entry)
+	}
+
+	for _, entry := range entries {
+		switch groupBy {
+		case GroupByDay:
+			add(entry.StartTime.Format("2006-01-02"), loc.Weekday(entry.StartTime)+", "+loc.Date(entry.StartTime), entry)
+		case GroupByWeek:
+			year, week := entry.StartTime.ISOWeek()
+			weekday := (int(entry.StartTime.Weekday()) + 6) % 7
+			monday := entry.StartTime.AddDate(0, 0, -weekday)
+			sunday := monday.AddDate(0, 0, 6)
+			key := fmt.Sprintf("%d-W%02d", year, week)
+			add(key, fmt.Sprintf("%s (%s - %s)", key, loc.WeekRangeDate(monday), loc.WeekRangeDate(sunday)), entry)
+		case GroupByTask:
+			label := entry.Description
+			if label == "" {
+				label = loc.T("no_description")
+			}
+			add(label, label, entry)
+		case GroupByTag:
+			if len(entry.Tags) == 0 {
+				add("", loc.T("untagged"), entry)
+			}
+			for _, tag := range entry.Tags {
+				add("#"+tag, tag, entry)
+			}
+		}
+	}
+
+	if groupBy == GroupByTask || groupBy == GroupByTag {
+		sort.SliceStable(groups, func(i, j int) bool {
+			return groups[i].label < groups[j].label
+		})
+	}
+
+	return groups
+}
+
+func (g *Generator) addGroupedRows(pdf *document, config ReportConfig, columns []tableColumn, effectiveRate float64, y float64) {
+	loc := lookupLocale(config.Locale)
+
+	for _, group := range groupTimeEntries(config.TimeEntries, config.GroupBy, loc) {
+		totals := sumEntryValues(group.entries, effectiveRate)
+
+		if config.Collapse {
+			y = pdf.nextRow(y)
+			pdf.SetFont(fontFamily, "", 8)
+		} else {
+			y = pdf.ensureSpace(y, 2*rowHeight)
+			pdf.SetFont(fontFamily, "B", 8)
+			pdf.SetFillColor(240, 248, 255)
+			pdf.Rect(10, y, 190, 6, "F")
+		}
+		g.addMergedCells(pdf, columns, 6, group.label, func(key string) string {
+			return groupCell(loc, key, totals)
+		})
+		y += rowHeight
+
+		if config.Collapse {
+			continue
+		}
+
+		pdf.SetFont(fontFamily, "", 8)
+		for _, entry := range group.entries {
+			y = pdf.nextRow(y)
+			g.addEntryCells(pdf, loc, columns, entry, entryValues(entry, effectiveRate), effectiveRate)
+			y += rowHeight
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package pdf
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const DefaultLocale = "en"
+
+type Locale struct {
+	Code         string
+	labels       map[string]string
+	date         string
+	dateTime     string
+	weekRange    string
+	decimalSep   string
+	thousandsSep string
+	symbolAfter  bool
+	nameReplacer *strings.Replacer
+}
+
+var currencySymbols = map[string]string{
+	"EUR": "€",
+	"USD": "$",
+	"GBP": "£",
+}
+
+var englishLabels = map[string]string{
+	"title":              "TIME TRACKING REPORT",
+	"project":            "Project",
+	"client":             "Client",
+	"projects":           "Projects",
+	"period":             "Period",
+	"all_time":           "all time",
+	"from":               "from %s",
+	"until":              "until %s",
+	"generated_on":       "Generated on %s",
+	"summary":            "SUMMARY",
+	"rate_line":          "Rate: %s/hr - All amounts in %s",
+	"amounts_in":         "All amounts in %s",
+	"total_hours":        "Total Hours",
+	"billable_hours":     "Billable Hours",
+	"non_billable_hours": "Non-Billable Hours",
+	"billable_amount":    "Billable Amount",
+	"time_entries":       "TIME ENTRIES",
+	"date":               "Date",
+	"start":              "Start",
+	"end":                "End",
+	"description":        "Description",
+	"tags":               "Tags",
+	"duration":           "Duration",
+	"billable":           "Billable",
+	"hours":              "Hours",
+	"rate":               "Rate",
+	"cost":               "Cost",
+	"amount":             "Amount",
+	"yes":                "Yes",
+	"no":                 "No",
+	"total":              "Total",
+	"subtotal":           "Subtotal %s: %s hours (%s billable)",
+	"day":                "Day",
+	"week":               "Week",
+	"task":               "Task",
+	"tag":                "Tag",
+	"untagged":           "Untagged",
+	"no_description":     "(no description)",
+	"page_of":            "Page %d of %s",
+}
+
+var germanLabels = map[string]string{
+	"title":              "ZEITERFASSUNGSBERICHT",
+	"project":            "Projekt",
+	"client":             "Kunde",
+	"projects":           "Projekte",
+	"period":             "Zeitraum",
+	"all_time":           "gesamter Zeitraum",
+	"from":               "ab %s",
+	"until":              "bis %s",
+	"generated_on":       "Erstellt am %s",
+	"summary":            "ZUSAMMENFASSUNG",
+	"rate_line":          "Stundensatz: %s/Std. - Alle Beträge in %s",
+	"amounts_in":         "Alle Beträge in %s",
+	"total_hours":        "Stunden gesamt",
+	"billable_hours":     "Abrechenbar",
+	"non_billable_hours": "Nicht abrechenbar",
+	"billable_amount":    "Betrag",
+	"time_entries":       "ZEITEINTRÄGE",
+	"date":               "Datum",
+	"start":              "Beginn",
+	"end":                "Ende",
+	"description":        "Beschreibung",
+	"tags":               "Tags",
+	"duration":           "Dauer",
+	"billable":           "Abrechenbar",
+	"hours":              "Stunden",
+	"rate":               "Satz",
+	"cost":               "Kosten",
+	"amount":             "Betrag",
+	"yes":                "Ja",
+	"no":                 "Nein",
+	"total":              "Gesamt",
+	"subtotal":           "Zwischensumme %s: %s Stunden (%s abrechenbar)",
+	"day":                "Tag",
+	"week":               "Woche",
+	"task":               "Aufgabe",
+	"tag":                "Tag",
+	"untagged":           "Ohne Tag",
+	"no_description":     "(keine Beschreibung)",
+	"page_of":            "Seite %d von %s",
+}
+
+var locales = map[string]*Locale{
+	"en": {
+		Code:         "en",
+		labels:       englishLabels,
+		date:         "2006-01-02",
+		dateTime:     "January 2, 2006 at 3:04 PM",
+		weekRange:    "Jan 2",
+		decimalSep:   ".",
+		thousandsSep: ",",
+	},
+	"de": {
+		Code:         "de",
+		labels:       germanLabels,
+		date:         "02.01.2006",
+		dateTime:     "2. January 2006 um 15:04",
+		weekRange:    "2. Jan",
+		decimalSep:   ",",
+		thousandsSep: ".",
+		symbolAfter:  true,
+		nameReplacer: strings.NewReplacer(
+			"January", "Januar", "February", "Februar", "March", "März",
+			"May", "Mai", "June", "Juni", "July", "Juli",
+			"October", "Oktober", "December", "Dezember",
+			"Mar", "Mär", "Oct", "Okt", "Dec", "Dez",
+			"Mon", "Mo", "Tue", "Di", "Wed", "Mi", "Thu", "Do",
+			"Fri", "Fr", "Sat", "Sa", "Sun", "So",
+		),
+	},
+}
+
+func IsSupportedLocale(code string) bool {
+	_, ok := locales[code]
+	return ok
+}
+
+func lookupLocale(code string) *Locale {
+	if locale, ok := locales[code]; ok {
+		return locale
+	}
+	return locales[DefaultLocale]
+}
+
+func (l *Locale) T(key string) string {
+	if label, ok := l.labels[key]; ok {
+		return label
+	}
+	return englishLabels[key]
+}
+
+func (l *Locale) Tf(key string, args ...interface{}) string {
+	return fmt.Sprintf(l.T(key), args...)
+}
+
+func (l *Locale) formatTime(t time.Time, layout string) string {
+	formatted := t.Format(layout)
+	if l.nameReplacer != nil {
+		formatted = l.nameReplacer.Replace(formatted)
+	}
+	return formatted
+}
+
+func (l *Locale) Date(t time.Time) string {
+	return l.formatTime(t, l.date)
+}
+
+func (l *Locale) DateTime(t time.Time) string {
+	return l.formatTime(t, l.dateTime)
+}
+
+func (l *Locale) Weekday(t time.Time) string {
+	return l.formatTime(t, "Mon")
+}
+
+func (l *Locale) WeekRangeDate(t time.Time) string {
+	return l.formatTime(t, l.weekRange)
+}
+
+// ParseAndFormatDate reformats a YYYY-MM-DD query date for display, leaving
+// values it cannot parse untouched.
+func (l *Locale) ParseAndFormatDate(value string) string {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return value
+	}
+	return l.Date(t)
+}
+
+func (l *Locale) FormatNumber(value float64, decimals int) string {
+	negative := value < 0
+	value = math.Abs(value)
+
+	formatted := fmt.Sprintf("%.*f", decimals, value)
+	intPart, fracPart := formatted, ""
+	if i := strings.IndexByte(formatted, '.'); i >= 0 {
+		intPart, fracPart = formatted[:i], formatted[i+1:]
+	}
+
+	var grouped strings.Builder
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			grouped.WriteString(l.thousandsSep)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	result := grouped.String()
+	if fracPart != "" {
+		result += l.decimalSep + fracPart
+	}
+	if negative && strings.Trim(result, "0"+l.decimalSep+l.thousandsSep) != "" {
+		result = "-" + result
+	}
+	return result
+}
+
+func (l *Locale) FormatHours(hours float64) string {
+	return l.FormatNumber(hours, 1)
+}
+
+func (l *Locale) FormatAmount(amount float64) string {
+	return l.FormatNumber(amount, 2)
+}
+
+func (l *Locale) FormatCurrency(amount float64, currencyCode string) string {
+	symbol, ok := currencySymbols[currencyCode]
+	if !ok {
+		return l.FormatAmount(amount) + " " + currencyCode
+	}
+	if l.symbolAfter {
+		return l.FormatAmount(amount) + " " + symbol
+	}
+	return symbol + l.FormatAmount(amount)
+}
@@ -0,0 +1,229 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+
+	"side-sync/pkg/models"
+)
+
+type MultiProjectReportConfig struct {
+	Projects       []models.Project
+	Client         *models.Client
+	TimeEntries    []models.TimeEntry
+	Settings       models.Settings
+	IncludePricing bool
+	DateFrom       string
+	DateTo         string
+	BillableFilter string
+	Branding       models.Branding
+	GroupBy        string
+	Collapse       bool
+	Locale         string
+	Columns        []string
+}
+
+type projectSection struct {
+	config        ReportConfig
+	totalHours    float64
+	billableHours float64
+	billableCost  float64
+	effectiveRate float64
+}
+
+// GenerateMultiProjectReport renders the report with dates and times in the
+// timezone of config.Settings.
+func (g *Generator) GenerateMultiProjectReport(config MultiProjectReportConfig) (*bytes.Buffer, error) {
+	tz := config.Settings.Location()
+	config.TimeEntries = models.LocalizeEntries(config.TimeEntries, tz)
+
+	pdf := g.newDocument(config.Branding, lookupLocale(config.Locale), tz)
+	pdf.AddPage()
+
+	sections, currency := g.buildProjectSections(config)
+
+	var totalHours, billableHours, billableCost float64
+	showAmount := false
+	for _, section := range sections {
+		totalHours += section.totalHours
+		billableHours += section.billableHours
+		billableCost += section.billableCost
+		if section.effectiveRate > 0 && config.IncludePricing {
+			showAmount = true
+		}
+	}
+
+	g.addMultiProjectHeader(pdf, config)
+	g.addMultiProjectSummary(pdf, config, sections, totalHours, billableHours, billableCost, showAmount, currency)
+
+	for _, section := range sections {
+		if len(section.config.TimeEntries) == 0 {
+			continue
+		}
+
+		g.addTimeEntriesTable(pdf, section.config, section.effectiveRate, currency, section.config.Project.Name)
+		g.addProjectSubtotal(pdf, section, currency)
+	}
+
+	var buf bytes.Buffer
+	err := pdf.Output(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %v", err)
+	}
+
+	return &buf, nil
+}
+
+func (g *Generator) buildProjectSections(config MultiProjectReportConfig) ([]projectSection, string) {
+	entriesByProject := make(map[int][]models.TimeEntry)
+	for _, entry := range config.TimeEntries {
+		entriesByProject[entry.ProjectID] = append(entriesByProject[entry.ProjectID], entry)
+	}
+
+	var sections []projectSection
+	currency := "EUR"
+	for _, project := range config.Projects {
+		section := projectSection{
+			config: ReportConfig{
+				Project:        project,
+				TimeEntries:    entriesByProject[project.ID],
+				Settings:       config.Settings,
+				IncludePricing: config.IncludePricing,
+				DateFrom:       config.DateFrom,
+				DateTo:         config.DateTo,
+				BillableFilter: config.BillableFilter,
+				Branding:       config.Branding,
+				GroupBy:        config.GroupBy,
+				Collapse:       config.Collapse,
+				Locale:         config.Locale,
+				Columns:        config.Columns,
+			},
+		}
+		section.totalHours, section.billableHours, _, section.billableCost, section.effectiveRate, currency = g.calculateTotals(section.config)
+		sections = append(sections, section)
+	}
+
+	return sections, currency
+}
+
+func (g *Generator) addMultiProjectHeader(pdf *document, config MultiProjectReportConfig) {
+	loc := lookupLocale(config.Locale)
+	g.addLetterhead(pdf, config.Branding)
+
+	pdf.SetFont(fontFamily, "B", 20)
+	pdf.SetTextColor(primaryColor(config.Branding))
+	pdf.Cell(190, 15, loc.T("title"))
+	pdf.Ln(12)
+
+	pdf.SetFont(fontFamily, "B", 16)
+	pdf.SetTextColor(0, 0, 0)
+	if config.Client != nil {
+		pdf.Cell(190, 10, fitText(pdf, fmt.Sprintf("%s: %s", loc.T("client"), config.Client.Name), 190))
+	} else {
+		pdf.Cell(190, 10, fmt.Sprintf("%s: %d", loc.T("projects"), len(config.Projects)))
+	}
+	pdf.Ln(10)
+
+	pdf.SetFont(fontFamily, "", 12)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.Cell(190, 6, fmt.Sprintf("%s: %s", loc.T("period"), formatPeriod(loc, config.DateFrom, config.DateTo)))
+	pdf.Ln(8)
+
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.SetTextColor(150, 150, 150)
+	pdf.Cell(190, 5, loc.Tf("generated_on", loc.DateTime(pdf.generatedAt)))
+}
+
+func (g *Generator) addMultiProjectSummary(pdf *document, config MultiProjectReportConfig, sections []projectSection, totalHours, billableHours, billableCost float64, showAmount bool, currency string) {
+	loc := lookupLocale(config.Locale)
+	_, currentY := pdf.GetXY()
+	summaryY := pdf.ensureSpace(currentY+8, 45)
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.SetXY(10, summaryY)
+	pdf.Cell(190, 8, loc.T("summary"))
+
+	rateY := summaryY + 12
+	if showAmount {
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.SetXY(10, rateY)
+		pdf.Cell(190, 5, loc.Tf("amounts_in", currency))
+		rateY += 8
+	}
+
+	g.addSummaryBoxes(pdf, loc, rateY+5, totalHours, billableHours, billableCost, showAmount, currency)
+
+	y := pdf.beginTable(pdf.ensureSpace(rateY+35, 8+2*rowHeight), func() {
+		headerY := pdf.GetY()
+		pdf.SetFillColor(primaryColor(config.Branding))
+		pdf.SetTextColor(255, 255, 255)
+		pdf.SetFont(fontFamily, "B", 9)
+		pdf.Rect(10, headerY, 190, 8, "F")
+		pdf.SetXY(10, headerY)
+		pdf.Cell(70, 8, loc.T("project"))
+		pdf.Cell(30, 8, loc.T("hours"))
+		pdf.Cell(30, 8, loc.T("billable_hours"))
+		if showAmount {
+			pdf.Cell(25, 8, loc.T("rate"))
+			pdf.Cell(35, 8, loc.T("amount"))
+		}
+	})
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont(fontFamily, "", 8)
+	for _, section := range sections {
+		y = pdf.nextRow(y)
+		pdf.Cell(70, 6, fitText(pdf, section.config.Project.Name, 70))
+		pdf.Cell(30, 6, loc.FormatHours(section.totalHours))
+		pdf.Cell(30, 6, loc.FormatHours(section.billableHours))
+		if showAmount {
+			pdf.Cell(25, 6, loc.FormatAmount(section.effectiveRate))
+			pdf.Cell(35, 6, loc.FormatCurrency(section.billableCost, currency))
+		}
+		y += rowHeight
+	}
+	pdf.endTable()
+
+	y = pdf.nextRow(y)
+	pdf.SetFont(fontFamily, "B", 8)
+	pdf.Cell(70, 6, loc.T("total"))
+	pdf.Cell(30, 6, loc.FormatHours(totalHours))
+	pdf.Cell(30, 6, loc.FormatHours(billableHours))
+	if showAmount {
+		pdf.Cell(25, 6, "")
+		pdf.Cell(35, 6, loc.FormatCurrency(billableCost, currency))
+	}
+}
+
+func (g *Generator) addProjectSubtotal(pdf *document, section projectSection, currency string) {
+	loc := lookupLocale(section.config.Locale)
+	_, y := pdf.GetXY()
+	y = pdf.ensureSpace(y+7, rowHeight+1)
+
+	pdf.SetDrawColor(primaryColor(section.config.Branding))
+	pdf.Line(10, y, 200, y)
+
+	pdf.SetFont(fontFamily, "B", 9)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetXY(10, y+1)
+
+	subtotal := loc.Tf("subtotal", section.config.Project.Name, loc.FormatHours(section.totalHours), loc.FormatHours(section.billableHours))
+	if section.effectiveRate > 0 && section.config.IncludePricing {
+		subtotal += " - " + loc.FormatCurrency(section.billableCost, currency)
+	}
+	pdf.Cell(190, 6, fitText(pdf, subtotal, 190))
+}
+
+func formatPeriod(loc *Locale, dateFrom, dateTo string) string {
+	switch {
+	case dateFrom != "" && dateTo != "":
+		return fmt.Sprintf("%s - %s", loc.ParseAndFormatDate(dateFrom), loc.ParseAndFormatDate(dateTo))
+	case dateFrom != "":
+		return loc.Tf("from", loc.ParseAndFormatDate(dateFrom))
+	case dateTo != "":
+		return loc.Tf("until", loc.ParseAndFormatDate(dateTo))
+	default:
+		return loc.T("all_time")
+	}
+}
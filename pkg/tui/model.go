@@ -1,9 +1,12 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	"side-sync/pkg/client"
 	"side-sync/pkg/models"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -21,7 +24,7 @@ const (
 )
 
 type Model struct {
-	client           *Client
+	client           *client.Client
 	projects         []models.Project
 	selectedProject  int
 	state            state
@@ -36,8 +39,12 @@ type Model struct {
 }
 
 func NewModel(apiURL string) Model {
+	api, err := client.New(apiURL, client.WithHTTPClient(&http.Client{Timeout: 10 * time.Second}))
+	if err != nil {
+		return Model{state: stateError, err: err}
+	}
 	return Model{
-		client:           NewClient(apiURL),
+		client:           api,
 		state:            stateSelectProject,
 		confirmSelection: 0,
 	}
@@ -48,9 +55,9 @@ type tickMsg time.Time
 type errorMsg error
 type successMsg struct{}
 
-func loadProjectsCmd(client *Client) tea.Cmd {
+func loadProjectsCmd(api *client.Client) tea.Cmd {
 	return func() tea.Msg {
-		projects, err := client.GetProjects()
+		projects, err := api.ListProjects(context.Background())
 		if err != nil {
 			return errorMsg(err)
 		}
@@ -65,6 +72,9 @@ func tickCmd() tea.Cmd {
 }
 
 func (m Model) Init() tea.Cmd {
+	if m.client == nil {
+		return nil
+	}
 	return loadProjectsCmd(m.client)
 }
 
@@ -276,7 +286,7 @@ func (m Model) saveTimeEntry() tea.Cmd {
 			Billable:    true,
 		}
 
-		_, err := m.client.CreateTimeEntry(entry)
+		_, err := m.client.CreateTimeEntry(context.Background(), entry)
 		if err != nil {
 			return errorMsg(err)
 		}